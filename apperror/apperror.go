@@ -0,0 +1,136 @@
+package apperror
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-chi/chi/middleware"
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/shufo/go-graphql-boilerplate/translations"
+	"github.com/sirupsen/logrus"
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+// Code is the machine readable error code set in extensions.code
+type Code string
+
+const (
+	CodeUnauthenticated  Code = "UNAUTHENTICATED"
+	CodeForbidden        Code = "FORBIDDEN"
+	CodeValidationFailed Code = "VALIDATION_FAILED"
+	CodeNotFound         Code = "NOT_FOUND"
+	CodeConflict         Code = "CONFLICT"
+	CodeRateLimited      Code = "RATE_LIMITED"
+	// CodeQueryLimitExceeded is for the query over the depth, complexity or list size limits
+	CodeQueryLimitExceeded Code = "QUERY_LIMIT_EXCEEDED"
+	CodeInternal           Code = "INTERNAL"
+)
+
+// New returns the error with the code and the message translated from the key
+func New(ctx context.Context, code Code, key string) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message:    translations.T(ctx, key),
+		Extensions: map[string]interface{}{"code": code},
+	}
+}
+
+// NewWithTemplateData is same as New but the message is rendered with the data
+func NewWithTemplateData(ctx context.Context, code Code, key string, data map[string]interface{}) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message:    translations.TWithTemplateData(ctx, key, data),
+		Extensions: map[string]interface{}{"code": code},
+	}
+}
+
+// Unauthenticated returns the error for the request without valid credentials
+func Unauthenticated(ctx context.Context) *gqlerror.Error {
+	return New(ctx, CodeUnauthenticated, "authentication_required")
+}
+
+// Forbidden returns the error for the request which is not granted
+func Forbidden(ctx context.Context) *gqlerror.Error {
+	return New(ctx, CodeForbidden, "permission_denied")
+}
+
+// Validation returns the error for invalid inputs.
+// errs is keyed by the path of the field like "input.email" and the message of
+// each field is set in extensions.fields. labels maps the path to the translated
+// field name shown in the message.
+func Validation(ctx context.Context, errs validation.Errors, labels map[string]string) *gqlerror.Error {
+	fields := map[string]interface{}{}
+	labeled := validation.Errors{}
+
+	for path, err := range errs {
+		fields[path] = err.Error()
+
+		label, ok := labels[path]
+
+		if !ok {
+			label = path
+		}
+
+		labeled[label] = err
+	}
+
+	return &gqlerror.Error{
+		Message: strings.TrimSuffix(labeled.Error(), "."),
+		Extensions: map[string]interface{}{
+			"code":   CodeValidationFailed,
+			"fields": fields,
+		},
+	}
+}
+
+// CodeOf returns the code of the error or CodeInternal for unexpected errors
+func CodeOf(err error) Code {
+	if e, ok := err.(*gqlerror.Error); ok {
+		if code, ok := e.Extensions["code"].(Code); ok {
+			return code
+		}
+
+		if code, ok := e.Extensions["code"].(string); ok {
+			return Code(code)
+		}
+	}
+
+	return CodeInternal
+}
+
+// Presenter returns the error presenter which marks unexpected errors like
+// database errors as INTERNAL. Such errors are logged and, if hideInternal is
+// true, their messages are replaced so that internal details are not exposed.
+// sql.ErrNoRows returned by the finders like models.FindUser is NOT_FOUND.
+func Presenter(logger logrus.FieldLogger, hideInternal bool) graphql.ErrorPresenterFunc {
+	return func(ctx context.Context, err error) *gqlerror.Error {
+		// errors built by resolvers are intended for clients
+		if _, ok := err.(*gqlerror.Error); ok {
+			return graphql.DefaultErrorPresenter(ctx, err)
+		}
+
+		if errors.Cause(err) == sql.ErrNoRows {
+			return graphql.DefaultErrorPresenter(ctx, New(ctx, CodeNotFound, "not_found"))
+		}
+
+		e := graphql.DefaultErrorPresenter(ctx, err)
+
+		logger.WithFields(logrus.Fields{
+			"req_id": middleware.GetReqID(ctx),
+			"path":   e.Path,
+		}).Error(err)
+
+		if hideInternal {
+			e.Message = translations.T(ctx, "internal_error")
+		}
+
+		if e.Extensions == nil {
+			e.Extensions = map[string]interface{}{}
+		}
+
+		e.Extensions["code"] = CodeInternal
+
+		return e
+	}
+}
@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/shufo/go-graphql-boilerplate/auth"
+	"github.com/shufo/go-graphql-boilerplate/clientip"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// Action represents what happened
+type Action string
+
+const (
+	ActionLoginSucceeded         Action = "login_succeeded"
+	ActionLoginFailed            Action = "login_failed"
+	ActionUserCreated            Action = "user_created"
+	ActionPasswordResetRequested Action = "password_reset_requested"
+	ActionPasswordResetCompleted Action = "password_reset_completed"
+	ActionRoleGranted            Action = "role_granted" // only the USER role granted at signup, as no mutation changes roles
+	ActionTokenRevoked           Action = "token_revoked"
+	ActionAdmin                  Action = "admin_action"
+)
+
+// Target types of the event
+const (
+	TargetUser                   = "user"
+	TargetAuthenticationProvider = "authentication_provider"
+	TargetPasswordReset          = "password_reset"
+	TargetAuthToken              = "auth_token"
+	TargetMailMessage            = "mail_message"
+)
+
+// Event represents a security relevant event
+type Event struct {
+	Action Action
+	// ActorID is the user who did the action. The authenticated user is used if it's nil
+	ActorID    *int
+	TargetType string
+	TargetID   *int
+	// Diff is the changes made by the action. Use Diff() to build it
+	Diff map[string]Change
+}
+
+// Change represents a value before and after the action
+type Change struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// Diff returns the changes between before and after.
+// Keys whose values are the same are omitted.
+func Diff(before map[string]interface{}, after map[string]interface{}) map[string]Change {
+	diff := map[string]Change{}
+
+	for k, v := range before {
+		if !reflect.DeepEqual(v, after[k]) {
+			diff[k] = Change{Before: v, After: after[k]}
+		}
+	}
+
+	for k, v := range after {
+		if _, found := before[k]; !found && v != nil {
+			diff[k] = Change{Before: nil, After: v}
+		}
+	}
+
+	return diff
+}
+
+// Record stores the event with actor, ip address and request id of the current request.
+// The ip address is the client's resolved by clientip.Middleware, so that it is not forged
+// by the proxy headers.
+func Record(ctx context.Context, exec boil.ContextExecutor, e Event) error {
+	ip := clientip.ForContext(ctx)
+
+	ae := &models.AuditEvent{
+		Action:     string(e.Action),
+		TargetType: null.NewString(e.TargetType, e.TargetType != ""),
+		TargetID:   null.IntFromPtr(e.TargetID),
+		IPAddress:  null.NewString(ip, ip != ""),
+		RequestID:  null.NewString(middleware.GetReqID(ctx), middleware.GetReqID(ctx) != ""),
+	}
+
+	if e.ActorID != nil {
+		ae.ActorID = null.IntFrom(*e.ActorID)
+	} else if userID, ok := auth.UserIDForContext(ctx); ok {
+		ae.ActorID = null.IntFrom(userID)
+	}
+
+	if len(e.Diff) > 0 {
+		b, err := json.Marshal(e.Diff)
+
+		if err != nil {
+			return err
+		}
+
+		ae.Diff = null.JSONFrom(b)
+	}
+
+	return ae.Insert(ctx, exec, boil.Infer())
+}
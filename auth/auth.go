@@ -3,8 +3,12 @@ package auth
 import (
 	"context"
 	"net/http"
+	"strings"
 
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/handler"
 	"github.com/shufo/go-graphql-boilerplate/logger"
+	"github.com/vektah/gqlparser/ast"
 
 	"github.com/go-chi/jwtauth"
 )
@@ -43,3 +47,60 @@ func ForContext(ctx context.Context) jwtauth.Claims {
 	raw, _ := ctx.Value(UserCtxKey).(jwtauth.Claims)
 	return raw
 }
+
+// UserIDForContext returns the user id in the claims of the context.
+// The second return value reports whether the user is authenticated.
+func UserIDForContext(ctx context.Context) (int, bool) {
+	id, ok := ForContext(ctx)["user_id"].(float64)
+
+	if !ok {
+		return 0, false
+	}
+
+	return int(id), true
+}
+
+// websocketSchema authenticates operations over websocket before executing them
+type websocketSchema struct {
+	graphql.ExecutableSchema
+	ja *jwtauth.JWTAuth
+}
+
+// WebsocketSchema wraps the schema to authenticate operations over websocket with
+// the JWT in the Authorization of the connection init payload, as browsers can't
+// set headers on websocket handshake. Operations over HTTP are executed as they are.
+func WebsocketSchema(es graphql.ExecutableSchema, ja *jwtauth.JWTAuth) graphql.ExecutableSchema {
+	return &websocketSchema{ExecutableSchema: es, ja: ja}
+}
+
+func (s *websocketSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	return s.ExecutableSchema.Query(s.authenticate(ctx), op)
+}
+
+func (s *websocketSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	return s.ExecutableSchema.Mutation(s.authenticate(ctx), op)
+}
+
+func (s *websocketSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
+	return s.ExecutableSchema.Subscription(s.authenticate(ctx), op)
+}
+
+// authenticate puts the token and claims in the init payload into context
+func (s *websocketSchema) authenticate(ctx context.Context) context.Context {
+	payload := handler.GetInitPayload(ctx)
+
+	if payload == nil {
+		return ctx
+	}
+
+	token, err := s.ja.Decode(strings.TrimPrefix(payload.Authorization(), "Bearer "))
+
+	if err == nil && (!token.Valid || jwtauth.IsExpired(token)) {
+		token, err = nil, jwtauth.ErrUnauthorized
+	}
+
+	ctx = jwtauth.NewContext(ctx, token, err)
+	_, claims, _ := jwtauth.FromContext(ctx)
+
+	return context.WithValue(ctx, UserCtxKey, claims)
+}
@@ -0,0 +1,101 @@
+// Package avatar converts uploaded images into square JPEG thumbnails
+package avatar
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+
+	"github.com/disintegration/gift"
+)
+
+// ContentType of the thumbnails
+const ContentType = "image/jpeg"
+
+// maxPixels is the limit of width * height of the source image.
+// It prevents huge images from exhausting the memory while decoding.
+const maxPixels = 4096 * 4096
+
+var (
+	// ErrUnsupportedImage is returned for files which are not JPEG, PNG or GIF
+	ErrUnsupportedImage = errors.New("unsupported image")
+	// ErrImageTooLarge is returned for images whose dimensions exceed the limit
+	ErrImageTooLarge = errors.New("image is too large")
+)
+
+// supported content types sniffed from the content
+var supported = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// Resize crops the center of the image and returns the thumbnails keyed by each size in pixels.
+// The content type is detected from the content, not from the file name or the header.
+func Resize(r io.ReadSeeker, sizes []int) (map[int][]byte, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(r, head)
+
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, ErrUnsupportedImage
+	}
+
+	if !supported[http.DetectContentType(head[:n])] {
+		return nil, ErrUnsupportedImage
+	}
+
+	// check the dimensions before decoding the whole image
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	config, _, err := image.DecodeConfig(r)
+
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+
+	if config.Width*config.Height > maxPixels {
+		return nil, ErrImageTooLarge
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	src, _, err := image.Decode(r)
+
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+
+	// flatten transparent pixels onto white as JPEG has no alpha channel
+	bg := image.NewRGBA(src.Bounds())
+	draw.Draw(bg, bg.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(bg, bg.Bounds(), src, src.Bounds().Min, draw.Over)
+
+	thumbnails := map[int][]byte{}
+
+	for _, size := range sizes {
+		g := gift.New(gift.ResizeToFill(size, size, gift.LanczosResampling, gift.CenterAnchor))
+		dst := image.NewRGBA(g.Bounds(bg.Bounds()))
+		g.Draw(dst, bg)
+
+		var buf bytes.Buffer
+
+		if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 85}); err != nil {
+			return nil, err
+		}
+
+		thumbnails[size] = buf.Bytes()
+	}
+
+	return thumbnails, nil
+}
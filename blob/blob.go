@@ -0,0 +1,38 @@
+// Package blob stores blobs such as uploaded images.
+// Use the local filesystem for development and S3 or an S3-compatible
+// service like MinIO in production.
+package blob
+
+import (
+	"context"
+	"io"
+
+	"github.com/shufo/go-graphql-boilerplate/configs"
+)
+
+// Storage puts blobs by the key and tells their public URLs
+type Storage interface {
+	// Put stores the content of r at the key
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	// Delete removes every blob whose key starts with the prefix
+	Delete(ctx context.Context, prefix string) error
+	// URL returns the URL to fetch the blob at the key
+	URL(key string) string
+}
+
+// New returns the Storage selected by the driver of the config.
+// "s3" stores blobs in S3Bucket, otherwise they are stored under LocalDir.
+// BaseURL is prepended to keys to build URLs.
+func New(config configs.Storage) Storage {
+	switch config.Driver {
+	case "s3":
+		return NewS3(S3Config{
+			Bucket:   config.S3Bucket,
+			Region:   config.S3Region,
+			Endpoint: config.S3Endpoint,
+			BaseURL:  config.BaseURL,
+		})
+	default:
+		return NewLocal(config.LocalDir, config.BaseURL)
+	}
+}
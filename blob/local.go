@@ -0,0 +1,94 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Local stores blobs as files under Dir
+type Local struct {
+	Dir     string
+	baseURL string
+}
+
+// NewLocal returns the storage writing files under dir
+func NewLocal(dir string, baseURL string) *Local {
+	return &Local{Dir: dir, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+func (s *Local) Put(ctx context.Context, key string, r io.Reader, contentType string) error {
+	path := s.path(key)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func (s *Local) Delete(ctx context.Context, prefix string) error {
+	return os.RemoveAll(s.path(prefix))
+}
+
+func (s *Local) URL(key string) string {
+	return s.baseURL + "/" + key
+}
+
+// Path returns the path of the base URL to mount Handler
+func (s *Local) Path() string {
+	u, err := url.Parse(s.baseURL)
+
+	if err != nil {
+		return s.baseURL
+	}
+
+	return u.Path
+}
+
+// Handler serves the stored files at Path. Directories are not listed.
+func (s *Local) Handler() http.Handler {
+	return http.StripPrefix(s.Path(), http.FileServer(filesOnly{http.Dir(s.Dir)}))
+}
+
+// filesOnly hides the directories so that http.FileServer doesn't list the files in them
+type filesOnly struct {
+	fs http.FileSystem
+}
+
+func (f filesOnly) Open(name string) (http.File, error) {
+	file, err := f.fs.Open(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+
+	if err != nil || info.IsDir() {
+		file.Close()
+		return nil, os.ErrNotExist
+	}
+
+	return file, nil
+}
+
+// path returns the file path of the key. Keys can't point outside of Dir.
+func (s *Local) path(key string) string {
+	return filepath.Join(s.Dir, filepath.FromSlash(filepath.Clean("/"+key)))
+}
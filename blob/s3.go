@@ -0,0 +1,112 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// S3Config is the setting of S3 storage
+type S3Config struct {
+	Bucket string
+	Region string
+	// Endpoint of S3-compatible service. Empty for AWS.
+	Endpoint string
+	// BaseURL to build URLs like CDN. The bucket URL is used if it is empty.
+	BaseURL string
+}
+
+// S3 stores blobs in the bucket of S3 or S3-compatible service
+type S3 struct {
+	client   *s3.S3
+	uploader *s3manager.Uploader
+	bucket   string
+	baseURL  string
+}
+
+// NewS3 returns the storage for the bucket. Credentials are taken from the environment.
+func NewS3(c S3Config) *S3 {
+	config := &aws.Config{Region: aws.String(c.Region)}
+
+	if c.Endpoint != "" {
+		config.Endpoint = aws.String(c.Endpoint)
+		config.S3ForcePathStyle = aws.Bool(true)
+	}
+
+	sess := session.Must(session.NewSession(config))
+
+	baseURL := c.BaseURL
+
+	if baseURL == "" {
+		if c.Endpoint != "" {
+			baseURL = strings.TrimSuffix(c.Endpoint, "/") + "/" + c.Bucket
+		} else {
+			baseURL = "https://" + c.Bucket + ".s3." + c.Region + ".amazonaws.com"
+		}
+	}
+
+	return &S3{
+		client:   s3.New(sess),
+		uploader: s3manager.NewUploader(sess),
+		bucket:   c.Bucket,
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (s *S3) Put(ctx context.Context, key string, r io.Reader, contentType string) error {
+	_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        r,
+		ContentType: aws.String(contentType),
+	})
+
+	return err
+}
+
+func (s *S3) Delete(ctx context.Context, prefix string) error {
+	var keys []*s3.ObjectIdentifier
+
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, last bool) bool {
+		for _, o := range page.Contents {
+			keys = append(keys, &s3.ObjectIdentifier{Key: o.Key})
+		}
+		return true
+	})
+
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+
+	// DeleteObjects accepts up to 1000 keys at once
+	for len(keys) > 0 {
+		n := len(keys)
+
+		if n > 1000 {
+			n = 1000
+		}
+
+		if _, err := s.client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(s.bucket),
+			Delete: &s3.Delete{Objects: keys[:n], Quiet: aws.Bool(true)},
+		}); err != nil {
+			return err
+		}
+
+		keys = keys[n:]
+	}
+
+	return nil
+}
+
+func (s *S3) URL(key string) string {
+	return s.baseURL + "/" + key
+}
@@ -0,0 +1,130 @@
+// Package clientip resolves the IP address of the client of the request.
+// X-Forwarded-For and X-Real-IP headers are honoured only when the request comes
+// from the trusted proxies, as any client can send them to forge the address.
+package clientip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+type contextKey struct {
+	name string
+}
+
+var ipCtxKey = &contextKey{name: "clientIP"}
+
+// Proxies is the networks of the trusted proxies
+type Proxies []*net.IPNet
+
+// ParseProxies parses the IP addresses and CIDRs like 10.0.0.0/8 of the trusted proxies
+func ParseProxies(proxies []string) (Proxies, error) {
+	var p Proxies
+
+	for _, s := range proxies {
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address of the proxy %q", s)
+			}
+
+			bits := 8 * net.IPv6len
+
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+
+			p = append(p, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(s)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR of the proxy %q", s)
+		}
+
+		p = append(p, network)
+	}
+
+	return p, nil
+}
+
+// Contains reports whether ip is the address of a trusted proxy
+func (p Proxies) Contains(ip net.IP) bool {
+	for _, network := range p {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Middleware replaces RemoteAddr of the request with the IP address of the client without port,
+// and puts the address in the context for ForContext.
+// It is the address of the socket peer unless the peer is a trusted proxy. Behind the proxies,
+// it is the rightmost address in X-Forwarded-For which is not a trusted proxy, as the addresses
+// on the left are given by the client, or X-Real-IP if X-Forwarded-For is missing.
+func Middleware(proxies Proxies) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.RemoteAddr = proxies.ClientIP(r)
+
+			ctx := context.WithValue(r.Context(), ipCtxKey, r.RemoteAddr)
+
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ClientIP returns the IP address of the client of the request
+func (p Proxies) ClientIP(r *http.Request) string {
+	peer := r.RemoteAddr
+
+	if host, _, err := net.SplitHostPort(peer); err == nil {
+		peer = host
+	}
+
+	if ip := net.ParseIP(peer); ip == nil || !p.Contains(ip) {
+		return peer
+	}
+
+	if forwarded := r.Header["X-Forwarded-For"]; len(forwarded) > 0 {
+		addrs := strings.Split(strings.Join(forwarded, ","), ",")
+
+		for i := len(addrs) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(addrs[i]))
+
+			// the address forged by the client is not trusted either
+			if ip == nil {
+				break
+			}
+
+			if !p.Contains(ip) || i == 0 {
+				return ip.String()
+			}
+		}
+
+		return peer
+	}
+
+	if ip := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); ip != nil {
+		return ip.String()
+	}
+
+	return peer
+}
+
+// ForContext returns the IP address of the client resolved by Middleware,
+// or an empty string if the request has not passed through it
+func ForContext(ctx context.Context) string {
+	ip, _ := ctx.Value(ipCtxKey).(string)
+	return ip
+}
@@ -0,0 +1,94 @@
+package clientip_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shufo/go-graphql-boilerplate/clientip"
+	"github.com/stretchr/testify/suite"
+)
+
+type ClientIPSuite struct {
+	suite.Suite
+}
+
+func (suite *ClientIPSuite) TestParseProxies() {
+	proxies, err := clientip.ParseProxies([]string{"10.0.0.0/8", "192.168.1.1", "::1"})
+	suite.Require().NoError(err)
+	suite.Require().Len(proxies, 3)
+	suite.Equal("10.0.0.0/8", proxies[0].String())
+	suite.Equal("192.168.1.1/32", proxies[1].String())
+	suite.Equal("::1/128", proxies[2].String())
+
+	for _, invalid := range []string{"example.jp", "10.0.0.0/33", ""} {
+		_, err := clientip.ParseProxies([]string{invalid})
+		suite.Error(err, invalid)
+	}
+}
+
+func (suite *ClientIPSuite) TestClientIP() {
+	proxies, err := clientip.ParseProxies([]string{"10.0.0.0/8"})
+	suite.Require().NoError(err)
+
+	for _, c := range []struct {
+		name      string
+		peer      string
+		forwarded []string
+		realIP    string
+		want      string
+	}{
+		{name: "direct", peer: "203.0.113.1:1234", want: "203.0.113.1"},
+		{name: "forged by untrusted peer", peer: "203.0.113.1:1234", forwarded: []string{"198.51.100.1"}, realIP: "198.51.100.2", want: "203.0.113.1"},
+		{name: "behind proxy", peer: "10.0.0.1:1234", forwarded: []string{"203.0.113.1"}, want: "203.0.113.1"},
+		{name: "forged behind proxy", peer: "10.0.0.1:1234", forwarded: []string{"198.51.100.1, 203.0.113.1"}, want: "203.0.113.1"},
+		{name: "behind proxies", peer: "10.0.0.1:1234", forwarded: []string{"198.51.100.1, 203.0.113.1, 10.0.0.2"}, want: "203.0.113.1"},
+		{name: "multiple headers", peer: "10.0.0.1:1234", forwarded: []string{"198.51.100.1", "203.0.113.1"}, want: "203.0.113.1"},
+		{name: "only proxies", peer: "10.0.0.1:1234", forwarded: []string{"10.0.0.3, 10.0.0.2"}, want: "10.0.0.3"},
+		{name: "invalid address", peer: "10.0.0.1:1234", forwarded: []string{"unknown, 10.0.0.2"}, want: "10.0.0.1"},
+		{name: "real ip", peer: "10.0.0.1:1234", realIP: "203.0.113.1", want: "203.0.113.1"},
+		{name: "no header", peer: "10.0.0.1:1234", want: "10.0.0.1"},
+	} {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.RemoteAddr = c.peer
+
+		for _, f := range c.forwarded {
+			r.Header.Add("X-Forwarded-For", f)
+		}
+
+		if c.realIP != "" {
+			r.Header.Set("X-Real-IP", c.realIP)
+		}
+
+		suite.Equal(c.want, proxies.ClientIP(r), c.name)
+	}
+}
+
+// The middleware puts the address of the client in RemoteAddr and the context
+func (suite *ClientIPSuite) TestMiddleware() {
+	proxies, err := clientip.ParseProxies([]string{"10.0.0.0/8"})
+	suite.Require().NoError(err)
+
+	var remoteAddr, ip string
+
+	h := clientip.Middleware(proxies)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remoteAddr = r.RemoteAddr
+		ip = clientip.ForContext(r.Context())
+	}))
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.RemoteAddr = "10.0.0.1:1234"
+	r.Header.Set("X-Forwarded-For", "203.0.113.1")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+
+	suite.Equal("203.0.113.1", remoteAddr)
+	suite.Equal("203.0.113.1", ip)
+
+	// the address is unknown without the middleware
+	suite.Empty(clientip.ForContext(context.Background()))
+}
+
+func TestClientIPSuite(t *testing.T) {
+	suite.Run(t, new(ClientIPSuite))
+}
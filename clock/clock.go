@@ -0,0 +1,53 @@
+// Package clock abstracts the current time so that it can be fixed in tests
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time
+type Clock interface {
+	Now() time.Time
+}
+
+// Real is the clock of the system
+type Real struct{}
+
+// Now returns the current local time
+func (Real) Now() time.Time {
+	return time.Now()
+}
+
+// Mock is the clock which only moves when it is told to
+type Mock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewMock returns the clock stopped at t
+func NewMock(t time.Time) *Mock {
+	return &Mock{now: t}
+}
+
+// Now returns the time the clock is set to
+func (m *Mock) Now() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.now
+}
+
+// Set sets the clock to t
+func (m *Mock) Set(t time.Time) {
+	m.mu.Lock()
+	m.now = t
+	m.mu.Unlock()
+}
+
+// Add moves the clock forward by d
+func (m *Mock) Add(d time.Duration) {
+	m.mu.Lock()
+	m.now = m.now.Add(d)
+	m.mu.Unlock()
+}
@@ -1,8 +1,405 @@
+// Package configs holds the settings of the application.
+//
+// The config is loaded by Load from the defaults, the YAML or TOML file and the
+// environment variables in this order. Each field is read from the key in the
+// config tag of the file and overridden by the environment variables in the env tag.
+// Fields tagged with secret are redacted by Print.
 package configs
 
-import "time"
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
-const (
-	// TokenLifetime is the duration expires after token issued
-	TokenLifetime = 24 * time.Hour
+	"github.com/shufo/go-graphql-boilerplate/clientip"
 )
+
+// Config holds the settings of the application
+type Config struct {
+	// Env is the name of the environment like production, development and local
+	Env string `config:"env" env:"APP_ENV"`
+	// Port is the port the server listens on
+	Port int `config:"port" env:"PORT"`
+	// BaseURL is the public URL of the server used to build links in mails like http://localhost:8080
+	BaseURL string `config:"base_url" env:"APP_URL"`
+	// JWTSecret signs the auth tokens
+	JWTSecret string `config:"jwt_secret" env:"JWT_SECRET" secret:"true"`
+	// TokenLifetime is the duration expires after token issued
+	TokenLifetime time.Duration `config:"token_lifetime" env:"TOKEN_LIFETIME"`
+	// PasswordResetLifetime is the duration the password reset token is valid
+	PasswordResetLifetime time.Duration `config:"password_reset_lifetime" env:"PASSWORD_RESET_LIFETIME"`
+	// UnsubscribeSecret signs the unsubscribe links in the mails. It must differ from JWTSecret
+	// so that the links can be revoked by rotating it without signing out every user.
+	UnsubscribeSecret string `config:"unsubscribe_secret" env:"UNSUBSCRIBE_SECRET" secret:"true"`
+	// UnsubscribeLifetime is the duration the unsubscribe links are valid after the mails are sent
+	UnsubscribeLifetime time.Duration `config:"unsubscribe_lifetime" env:"UNSUBSCRIBE_LIFETIME"`
+	// PasswordResetURL is the URL of the page to reset the password linked from the mails.
+	// {token} in it is replaced with the reset token. It is the page on BaseURL by default.
+	PasswordResetURL string `config:"password_reset_url" env:"PASSWORD_RESET_URL"`
+	// PrivacyMode hides whether accounts exist from signup and password reset responses
+	// and mails the owners of the addresses instead. Internal deployments may disable it
+	// to show the errors like email_already_exists.
+	PrivacyMode bool `config:"privacy_mode" env:"PRIVACY_MODE"`
+	// MetricsEnabled exposes the runtime metrics at /debug/vars
+	MetricsEnabled bool `config:"metrics_enabled" env:"METRICS_ENABLED"`
+	// PersistedQueryManifest is the path to the manifest of the queries accepted.
+	// Any query is accepted if it is empty.
+	PersistedQueryManifest string `config:"persisted_query_manifest" env:"PERSISTED_QUERY_MANIFEST"`
+	// TrustedProxies are the IP addresses and CIDRs of the proxies like load balancers.
+	// X-Forwarded-For and X-Real-IP headers are honoured only from them to find the client address.
+	TrustedProxies []string `config:"trusted_proxies" env:"TRUSTED_PROXIES"`
+
+	DB      DB      `config:"db"`
+	Redis   Redis   `config:"redis"`
+	CORS    CORS    `config:"cors"`
+	Mail    Mail    `config:"mail"`
+	Storage Storage `config:"storage"`
+	PubSub  PubSub  `config:"pubsub"`
+	SES     SES     `config:"ses"`
+
+	MailQueue  MailQueue  `config:"mail_queue"`
+	RateLimit  RateLimit  `config:"rate_limit"`
+	QueryLimit QueryLimit `config:"query_limit"`
+}
+
+// DB is the MySQL database
+type DB struct {
+	Host     string `config:"host" env:"DB_HOST"`
+	Port     int    `config:"port" env:"DB_PORT"`
+	Username string `config:"username" env:"DB_USERNAME"`
+	Password string `config:"password" env:"DB_PASSWORD" secret:"true"`
+	Database string `config:"database" env:"DB_DATABASE"`
+}
+
+// DSN returns the data source name to open the database
+func (d DB) DSN() string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local", d.Username, d.Password, d.Host, d.Port, d.Database)
+}
+
+// Redis keeps the policies, the persisted queries, the rate limits and the messages of subscriptions
+type Redis struct {
+	Host string `config:"host" env:"REDIS_HOST"`
+	Port int    `config:"port" env:"REDIS_PORT"`
+}
+
+// Address returns the address to connect like localhost:6379
+func (r Redis) Address() string {
+	return fmt.Sprintf("%s:%d", r.Host, r.Port)
+}
+
+// CORS is the origins allowed to send requests from browsers
+type CORS struct {
+	// AllowedOrigins are the origins like https://www.example.jp. "*" allows any origin without
+	// the credentials. No origin is allowed by default.
+	AllowedOrigins []string `config:"allowed_origins" env:"CORS_ALLOWED_ORIGINS"`
+}
+
+// Mail is the transport of the mails
+type Mail struct {
+	// Driver is "ses", "smtp", "outbox" or "log"
+	Driver string `config:"driver" env:"MAIL_DRIVER"`
+	// From is the "From" address. It must be verified with Amazon SES to send by SES.
+	From string `config:"from" env:"MAIL_FROM"`
+	// SESRegion is the region of Amazon SES
+	SESRegion    string `config:"ses_region" env:"MAIL_SES_REGION,AWS_DEFAULT_REGION"`
+	SMTPHost     string `config:"smtp_host" env:"MAIL_SMTP_HOST"`
+	SMTPPort     int    `config:"smtp_port" env:"MAIL_SMTP_PORT"`
+	SMTPUsername string `config:"smtp_username" env:"MAIL_SMTP_USERNAME"`
+	SMTPPassword string `config:"smtp_password" env:"MAIL_SMTP_PASSWORD" secret:"true"`
+	// SMTPTimeout is the limit of connecting to the SMTP server and of each read and write
+	SMTPTimeout time.Duration `config:"smtp_timeout" env:"MAIL_SMTP_TIMEOUT"`
+	// OutboxDir is the directory the outbox writes mails to. They are only kept in memory if it is empty.
+	OutboxDir string `config:"outbox_dir" env:"MAIL_OUTBOX_DIR"`
+}
+
+// Storage is the storage of the uploaded files
+type Storage struct {
+	// Driver is "s3" or "local"
+	Driver string `config:"driver" env:"STORAGE_DRIVER"`
+	// BaseURL is prepended to the keys to build the URLs of the files
+	BaseURL    string `config:"base_url" env:"STORAGE_BASE_URL"`
+	LocalDir   string `config:"local_dir" env:"STORAGE_LOCAL_DIR"`
+	S3Bucket   string `config:"s3_bucket" env:"STORAGE_S3_BUCKET"`
+	S3Region   string `config:"s3_region" env:"STORAGE_S3_REGION,AWS_DEFAULT_REGION"`
+	S3Endpoint string `config:"s3_endpoint" env:"STORAGE_S3_ENDPOINT"`
+}
+
+// PubSub delivers the messages of subscriptions
+type PubSub struct {
+	// Driver is "redis" to deliver messages across servers or "memory"
+	Driver string `config:"driver" env:"PUBSUB_DRIVER"`
+}
+
+// SES is the notifications of bounces and complaints from Amazon SES
+type SES struct {
+	// WebhookEnabled mounts /webhooks/ses receiving the notifications from SNS
+	WebhookEnabled bool `config:"webhook_enabled" env:"SES_WEBHOOK_ENABLED"`
+	// SNSTopicARNs are the SNS topics allowed to send the notifications. It is required by the webhook.
+	SNSTopicARNs []string `config:"sns_topic_arns" env:"SES_SNS_TOPIC_ARNS"`
+}
+
+// MailQueue is the delivery of the mails queued in the database
+type MailQueue struct {
+	// Workers is the number of mails delivered at the same time
+	Workers int `config:"workers" env:"MAIL_QUEUE_WORKERS"`
+	// PollInterval is the interval to look for due mails
+	PollInterval time.Duration `config:"poll_interval" env:"MAIL_QUEUE_POLL_INTERVAL"`
+	// MaxAttempts is the number of attempts before the mail is marked as failed
+	MaxAttempts int `config:"max_attempts" env:"MAIL_QUEUE_MAX_ATTEMPTS"`
+	// Backoff is the delay before the first retry. It is doubled for each retry.
+	Backoff time.Duration `config:"backoff" env:"MAIL_QUEUE_BACKOFF"`
+	// MaxBackoff is the upper bound of the delay
+	MaxBackoff time.Duration `config:"max_backoff" env:"MAIL_QUEUE_MAX_BACKOFF"`
+	// LockTimeout is the time after which a mail left in sending is delivered again,
+	// in case the worker delivering it has stopped
+	LockTimeout time.Duration `config:"lock_timeout" env:"MAIL_QUEUE_LOCK_TIMEOUT"`
+	// SendTimeout is the limit of each attempt to deliver a mail. It must be less than
+	// LockTimeout so that the mail is not delivered again while it is being sent.
+	SendTimeout time.Duration `config:"send_timeout" env:"MAIL_QUEUE_SEND_TIMEOUT"`
+}
+
+// RateLimit is the limits of the requests from each client
+type RateLimit struct {
+	// Store is "redis" to share the limits among servers, or "memory"
+	Store string `config:"store" env:"RATE_LIMIT_STORE"`
+	// Requests is the number of the requests allowed in Window
+	Requests int           `config:"requests" env:"RATE_LIMIT_REQUESTS"`
+	Window   time.Duration `config:"window" env:"RATE_LIMIT_WINDOW"`
+	// OperationRequests is the number of each named operation allowed in OperationWindow
+	OperationRequests int           `config:"operation_requests" env:"RATE_LIMIT_OPERATION_REQUESTS"`
+	OperationWindow   time.Duration `config:"operation_window" env:"RATE_LIMIT_OPERATION_WINDOW"`
+}
+
+// QueryLimit is the maximum depth and complexity of the operations for each kind of user.
+// 0 disables the limit.
+type QueryLimit struct {
+	MaxDepth                   int `config:"max_depth" env:"QUERY_MAX_DEPTH"`
+	MaxComplexity              int `config:"max_complexity" env:"QUERY_MAX_COMPLEXITY"`
+	MaxDepthAuthenticated      int `config:"max_depth_authenticated" env:"QUERY_MAX_DEPTH_AUTHENTICATED"`
+	MaxComplexityAuthenticated int `config:"max_complexity_authenticated" env:"QUERY_MAX_COMPLEXITY_AUTHENTICATED"`
+	MaxDepthAdmin              int `config:"max_depth_admin" env:"QUERY_MAX_DEPTH_ADMIN"`
+	MaxComplexityAdmin         int `config:"max_complexity_admin" env:"QUERY_MAX_COMPLEXITY_ADMIN"`
+}
+
+// Default returns the config used unless the values are given
+func Default() Config {
+	return Config{
+		Env:                   "local",
+		Port:                  8080,
+		BaseURL:               "http://localhost:8080",
+		TokenLifetime:         24 * time.Hour,
+		PasswordResetLifetime: 24 * time.Hour,
+		UnsubscribeLifetime:   365 * 24 * time.Hour,
+		DB:                    DB{Port: 3306},
+		Redis:                 Redis{Host: "localhost", Port: 6379},
+		Mail:                  Mail{Driver: "log", From: "info@example.co.jp", SMTPPort: 587, SMTPTimeout: 30 * time.Second},
+		Storage:               Storage{Driver: "local", BaseURL: "/storage", LocalDir: "storage/public"},
+		PubSub:                PubSub{Driver: "memory"},
+		MailQueue: MailQueue{
+			Workers:      4,
+			PollInterval: time.Second,
+			MaxAttempts:  8,
+			Backoff:      30 * time.Second,
+			MaxBackoff:   time.Hour,
+			LockTimeout:  5 * time.Minute,
+			SendTimeout:  time.Minute,
+		},
+		RateLimit: RateLimit{
+			Store:             "redis",
+			Requests:          300,
+			Window:            time.Minute,
+			OperationRequests: 120,
+			OperationWindow:   time.Minute,
+		},
+		QueryLimit: QueryLimit{
+			MaxDepth:                   10,
+			MaxComplexity:              200,
+			MaxDepthAuthenticated:      15,
+			MaxComplexityAuthenticated: 1000,
+			MaxDepthAdmin:              20,
+			MaxComplexityAdmin:         5000,
+		},
+	}
+}
+
+// PasswordResetLink returns PasswordResetURL with the token
+func (c Config) PasswordResetLink(token string) string {
+	return strings.Replace(c.PasswordResetURL, "{token}", url.QueryEscape(token), -1)
+}
+
+// Validate returns Problems listing every invalid setting
+func (c Config) Validate() error {
+	var p Problems
+
+	if c.JWTSecret == "" {
+		p.add("jwt_secret is required")
+	}
+
+	if c.UnsubscribeSecret == "" {
+		p.add("unsubscribe_secret is required")
+	} else if c.UnsubscribeSecret == c.JWTSecret {
+		p.add("unsubscribe_secret must differ from jwt_secret")
+	}
+
+	if c.Port <= 0 || c.Port > 65535 {
+		p.add("port must be between 1 and 65535")
+	}
+
+	if !isHTTPURL(c.BaseURL) {
+		p.add("base_url must be an absolute http or https URL")
+	}
+
+	if !isHTTPURL(c.PasswordResetURL) || !strings.Contains(c.PasswordResetURL, "{token}") {
+		p.add("password_reset_url must be an absolute http or https URL including {token}")
+	}
+
+	if c.TokenLifetime <= 0 {
+		p.add("token_lifetime must be positive")
+	}
+
+	if c.PasswordResetLifetime <= 0 {
+		p.add("password_reset_lifetime must be positive")
+	}
+
+	if c.UnsubscribeLifetime <= 0 {
+		p.add("unsubscribe_lifetime must be positive")
+	}
+
+	for _, f := range []struct{ name, value string }{
+		{"db.host", c.DB.Host},
+		{"db.username", c.DB.Username},
+		{"db.database", c.DB.Database},
+		{"redis.host", c.Redis.Host},
+	} {
+		if f.value == "" {
+			p.add(f.name + " is required")
+		}
+	}
+
+	for _, o := range c.CORS.AllowedOrigins {
+		switch {
+		case o == "*" && c.Env == "production":
+			p.add("cors.allowed_origins must list the origins instead of * in production")
+		case o != "*" && !isOrigin(o):
+			p.add(fmt.Sprintf("cors.allowed_origins has invalid origin %q", o))
+		}
+	}
+
+	if _, err := clientip.ParseProxies(c.TrustedProxies); err != nil {
+		p.add("trusted_proxies has " + err.Error())
+	}
+
+	switch c.Mail.Driver {
+	case "ses":
+		if c.Mail.SESRegion == "" {
+			p.add("mail.ses_region is required by ses driver")
+		}
+	case "smtp":
+		if c.Mail.SMTPHost == "" {
+			p.add("mail.smtp_host is required by smtp driver")
+		}
+	case "outbox", "log":
+	default:
+		p.add(fmt.Sprintf("mail.driver must be ses, smtp, outbox or log but %q", c.Mail.Driver))
+	}
+
+	if !strings.Contains(c.Mail.From, "@") {
+		p.add("mail.from must be an email address")
+	}
+
+	switch c.Storage.Driver {
+	case "s3":
+		if c.Storage.S3Bucket == "" {
+			p.add("storage.s3_bucket is required by s3 driver")
+		}
+	case "local":
+		if c.Storage.LocalDir == "" {
+			p.add("storage.local_dir is required by local driver")
+		}
+	default:
+		p.add(fmt.Sprintf("storage.driver must be s3 or local but %q", c.Storage.Driver))
+	}
+
+	switch c.PubSub.Driver {
+	case "redis", "memory":
+	default:
+		p.add(fmt.Sprintf("pubsub.driver must be redis or memory but %q", c.PubSub.Driver))
+	}
+
+	if c.SES.WebhookEnabled && len(c.SES.SNSTopicARNs) == 0 {
+		p.add("ses.sns_topic_arns is required by the webhook")
+	}
+
+	for _, arn := range c.SES.SNSTopicARNs {
+		if !strings.HasPrefix(arn, "arn:aws") || strings.Count(arn, ":") != 5 {
+			p.add(fmt.Sprintf("ses.sns_topic_arns has invalid ARN %q", arn))
+		}
+	}
+
+	for _, f := range []struct {
+		name  string
+		value int64
+	}{
+		{"mail_queue.workers", int64(c.MailQueue.Workers)},
+		{"mail_queue.poll_interval", int64(c.MailQueue.PollInterval)},
+		{"mail_queue.max_attempts", int64(c.MailQueue.MaxAttempts)},
+		{"mail_queue.backoff", int64(c.MailQueue.Backoff)},
+		{"mail_queue.lock_timeout", int64(c.MailQueue.LockTimeout)},
+		{"mail_queue.send_timeout", int64(c.MailQueue.SendTimeout)},
+		{"mail.smtp_timeout", int64(c.Mail.SMTPTimeout)},
+		{"rate_limit.requests", int64(c.RateLimit.Requests)},
+		{"rate_limit.window", int64(c.RateLimit.Window)},
+		{"rate_limit.operation_requests", int64(c.RateLimit.OperationRequests)},
+		{"rate_limit.operation_window", int64(c.RateLimit.OperationWindow)},
+	} {
+		if f.value <= 0 {
+			p.add(f.name + " must be positive")
+		}
+	}
+
+	if c.MailQueue.MaxBackoff < c.MailQueue.Backoff {
+		p.add("mail_queue.max_backoff must not be less than mail_queue.backoff")
+	}
+
+	if c.MailQueue.SendTimeout >= c.MailQueue.LockTimeout {
+		p.add("mail_queue.send_timeout must be less than mail_queue.lock_timeout")
+	}
+
+	switch c.RateLimit.Store {
+	case "redis", "memory":
+	default:
+		p.add(fmt.Sprintf("rate_limit.store must be redis or memory but %q", c.RateLimit.Store))
+	}
+
+	for _, f := range []struct {
+		name  string
+		value int
+	}{
+		{"query_limit.max_depth", c.QueryLimit.MaxDepth},
+		{"query_limit.max_complexity", c.QueryLimit.MaxComplexity},
+		{"query_limit.max_depth_authenticated", c.QueryLimit.MaxDepthAuthenticated},
+		{"query_limit.max_complexity_authenticated", c.QueryLimit.MaxComplexityAuthenticated},
+		{"query_limit.max_depth_admin", c.QueryLimit.MaxDepthAdmin},
+		{"query_limit.max_complexity_admin", c.QueryLimit.MaxComplexityAdmin},
+	} {
+		if f.value < 0 {
+			p.add(f.name + " must not be negative")
+		}
+	}
+
+	return p.err()
+}
+
+func isHTTPURL(s string) bool {
+	u, err := url.Parse(s)
+
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// isOrigin reports whether s is the origin like https://www.example.jp without path
+func isOrigin(s string) bool {
+	u, err := url.Parse(s)
+
+	return err == nil && isHTTPURL(s) && u.Path == "" && u.RawQuery == "" && u.Fragment == ""
+}
@@ -0,0 +1,251 @@
+package configs_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConfigSuite struct {
+	suite.Suite
+	dir string
+	// env keeps the environment variables set before each test to restore them.
+	// The variables which were not set are missing from it.
+	env map[string]string
+}
+
+// variables are the environment variables changed by the tests
+var variables = []string{
+	"APP_ENV", "APP_URL", "JWT_SECRET", "UNSUBSCRIBE_SECRET", "TOKEN_LIFETIME", "PRIVACY_MODE",
+	"DB_HOST", "DB_PORT", "DB_USERNAME", "DB_PASSWORD", "DB_DATABASE",
+	"CORS_ALLOWED_ORIGINS", "MAIL_DRIVER", "STORAGE_DRIVER", "SES_WEBHOOK_ENABLED", "SES_SNS_TOPIC_ARNS",
+	"MAIL_QUEUE_WORKERS", "MAIL_QUEUE_SEND_TIMEOUT", "RATE_LIMIT_WINDOW",
+}
+
+func (suite *ConfigSuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "config")
+	suite.Require().NoError(err)
+	suite.dir = dir
+
+	suite.env = map[string]string{}
+
+	for _, name := range variables {
+		if value, ok := os.LookupEnv(name); ok {
+			suite.env[name] = value
+		}
+
+		os.Unsetenv(name)
+	}
+}
+
+func (suite *ConfigSuite) TearDownTest() {
+	os.RemoveAll(suite.dir)
+
+	for _, name := range variables {
+		if value, ok := suite.env[name]; ok {
+			os.Setenv(name, value)
+		} else {
+			os.Unsetenv(name)
+		}
+	}
+}
+
+// write writes the config file and returns the path
+func (suite *ConfigSuite) write(name string, content string) string {
+	path := filepath.Join(suite.dir, name)
+	suite.Require().NoError(ioutil.WriteFile(path, []byte(content), 0644))
+
+	return path
+}
+
+// The file is overridden by the environment variables
+func (suite *ConfigSuite) TestLoad() {
+	for name, content := range map[string]string{
+		"config.yaml": `
+env: production
+base_url: https://api.example.jp/
+jwt_secret: secret
+unsubscribe_secret: unsubscribe-secret
+token_lifetime: 1h
+db:
+  host: db
+  username: app
+  database: example
+cors:
+  allowed_origins:
+    - https://www.example.jp
+`,
+		"config.toml": `
+env = "production"
+base_url = "https://api.example.jp/"
+jwt_secret = "secret"
+unsubscribe_secret = "unsubscribe-secret"
+token_lifetime = "1h"
+
+[db]
+host = "db"
+username = "app"
+database = "example"
+
+[cors]
+allowed_origins = ["https://www.example.jp"]
+`,
+	} {
+		os.Setenv("DB_HOST", "mysql")
+		os.Setenv("PRIVACY_MODE", "true")
+
+		c, err := configs.Load(suite.write(name, content))
+		suite.Require().NoError(err, name)
+
+		suite.Equal("production", c.Env, name)
+		suite.Equal("https://api.example.jp", c.BaseURL, name)
+		suite.Equal("https://api.example.jp/password/reset?token={token}", c.PasswordResetURL, name)
+		suite.Equal(time.Hour, c.TokenLifetime, name)
+		suite.Equal(24*time.Hour, c.PasswordResetLifetime, name)
+		suite.Equal(configs.DB{Host: "mysql", Port: 3306, Username: "app", Database: "example"}, c.DB, name)
+		suite.Equal([]string{"https://www.example.jp"}, c.CORS.AllowedOrigins, name)
+		suite.True(c.PrivacyMode, name)
+	}
+
+	// the environment variables are enough without the file
+	os.Setenv("JWT_SECRET", "secret")
+	os.Setenv("UNSUBSCRIBE_SECRET", "unsubscribe-secret")
+	os.Setenv("DB_HOST", "mysql")
+	os.Setenv("CORS_ALLOWED_ORIGINS", "https://www.example.jp, https://admin.example.jp")
+
+	c, err := configs.Load("")
+	suite.Require().Error(err)
+	suite.Equal(configs.Problems{"db.username is required", "db.database is required"}, err)
+	suite.Equal([]string{"https://www.example.jp", "https://admin.example.jp"}, c.CORS.AllowedOrigins)
+}
+
+// No origin is allowed by CORS unless it is listed
+func (suite *ConfigSuite) TestDefaultCORS() {
+	os.Setenv("JWT_SECRET", "secret")
+	os.Setenv("UNSUBSCRIBE_SECRET", "unsubscribe-secret")
+	os.Setenv("DB_HOST", "mysql")
+	os.Setenv("DB_USERNAME", "app")
+	os.Setenv("DB_DATABASE", "example")
+
+	c, err := configs.Load("")
+	suite.Require().NoError(err)
+	suite.Empty(c.CORS.AllowedOrigins)
+}
+
+// Every problem is listed at once
+func (suite *ConfigSuite) TestValidate() {
+	os.Setenv("MAIL_DRIVER", "sendmail")
+	os.Setenv("TOKEN_LIFETIME", "forever")
+	os.Setenv("MAIL_QUEUE_WORKERS", "four")
+	os.Setenv("RATE_LIMIT_WINDOW", "0s")
+	os.Setenv("MAIL_QUEUE_SEND_TIMEOUT", "10m")
+
+	_, err := configs.Load(suite.write("config.yml", `
+env: production
+base_url: example.jp
+password_reset_url: https://www.example.jp/password/reset
+trusted_proxies: ["10.0.0.0/8", "proxy"]
+db:
+  host: db
+  usename: app
+cors:
+  allowed_origins: ["*", "https://www.example.jp/"]
+storage:
+  driver: s3
+ses:
+  webhook_enabled: true
+rate_limit:
+  store: file
+query_limit:
+  max_depth: -1
+`))
+
+	suite.Equal(configs.Problems{
+		"db.usename is unknown",
+		"TOKEN_LIFETIME must be a duration like 24h but \"forever\"",
+		"MAIL_QUEUE_WORKERS must be an integer but \"four\"",
+		"jwt_secret is required",
+		"unsubscribe_secret is required",
+		"base_url must be an absolute http or https URL",
+		"password_reset_url must be an absolute http or https URL including {token}",
+		"db.username is required",
+		"db.database is required",
+		"cors.allowed_origins must list the origins instead of * in production",
+		"cors.allowed_origins has invalid origin \"https://www.example.jp/\"",
+		"trusted_proxies has invalid IP address of the proxy \"proxy\"",
+		"mail.driver must be ses, smtp, outbox or log but \"sendmail\"",
+		"storage.s3_bucket is required by s3 driver",
+		"ses.sns_topic_arns is required by the webhook",
+		"rate_limit.window must be positive",
+		"mail_queue.send_timeout must be less than mail_queue.lock_timeout",
+		"rate_limit.store must be redis or memory but \"file\"",
+		"query_limit.max_depth must not be negative",
+	}, err)
+	suite.Contains(err.Error(), "invalid config:\n  - db.usename is unknown\n")
+}
+
+// Secrets are redacted in the printed config
+func (suite *ConfigSuite) TestPrint() {
+	c := configs.Default()
+	c.JWTSecret = "jwt-secret"
+	c.UnsubscribeSecret = "unsubscribe-secret"
+	c.DB.Password = "db-password"
+
+	var b bytes.Buffer
+	suite.Require().NoError(configs.Print(&b, c))
+
+	suite.NotContains(b.String(), "jwt-secret")
+	suite.NotContains(b.String(), "unsubscribe-secret")
+	suite.NotContains(b.String(), "db-password")
+	suite.Contains(b.String(), "jwt_secret: '[REDACTED]'\n")
+	suite.Contains(b.String(), "token_lifetime: 24h0m0s\n")
+	suite.Contains(b.String(), "rate_limit:\n  store: redis\n  requests: 300\n  window: 1m0s\n")
+	// empty secrets are shown to tell they are not set
+	suite.Contains(b.String(), "  smtp_password: \"\"\n")
+
+	// the printed config can be loaded
+	os.Setenv("JWT_SECRET", "secret")
+	os.Setenv("UNSUBSCRIBE_SECRET", "unsubscribe-secret")
+	os.Setenv("DB_PASSWORD", "password")
+
+	c.DB = configs.DB{Host: "db", Port: 3306, Username: "app", Database: "example"}
+	b.Reset()
+	suite.Require().NoError(configs.Print(&b, c))
+
+	loaded, err := configs.Load(suite.write("config.yaml", b.String()))
+	suite.Require().NoError(err)
+	suite.Equal("secret", loaded.JWTSecret)
+	suite.Equal("unsubscribe-secret", loaded.UnsubscribeSecret)
+	suite.Equal("password", loaded.DB.Password)
+	suite.Equal(c.TokenLifetime, loaded.TokenLifetime)
+}
+
+// The variables which were not set before the test are unset after it
+func TestConfigSuiteTearDown(t *testing.T) {
+	s := new(ConfigSuite)
+	s.SetT(t)
+
+	value, set := os.LookupEnv("PRIVACY_MODE")
+	os.Unsetenv("PRIVACY_MODE")
+
+	s.SetupTest()
+	os.Setenv("PRIVACY_MODE", "true")
+	s.TearDownTest()
+
+	_, ok := os.LookupEnv("PRIVACY_MODE")
+	s.False(ok)
+
+	if set {
+		os.Setenv("PRIVACY_MODE", value)
+	}
+}
+
+func TestConfigSuite(t *testing.T) {
+	suite.Run(t, new(ConfigSuite))
+}
@@ -0,0 +1,257 @@
+package configs
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+)
+
+// Problems is the list of the invalid settings
+type Problems []string
+
+func (p Problems) Error() string {
+	return "invalid config:\n  - " + strings.Join(p, "\n  - ")
+}
+
+func (p *Problems) add(problem string) {
+	*p = append(*p, problem)
+}
+
+// err returns nil if there is no problem
+func (p Problems) err() error {
+	if len(p) == 0 {
+		return nil
+	}
+
+	return p
+}
+
+// Load returns the config read from the file at path overridden by the environment variables.
+// The file is read as TOML if the extension is .toml, otherwise as YAML. It is skipped if path is empty.
+// The error lists every problem of the file, the environment variables and Validate.
+// The config is returned with the error so that it can be printed.
+func Load(path string) (Config, error) {
+	c := Default()
+
+	var p Problems
+
+	if path != "" {
+		values, err := readFile(path)
+
+		if err != nil {
+			return c, err
+		}
+
+		p = append(p, fromFile(reflect.ValueOf(&c).Elem(), values, "")...)
+	}
+
+	p = append(p, fromEnv(reflect.ValueOf(&c).Elem())...)
+
+	if c.PasswordResetURL == "" {
+		c.PasswordResetURL = strings.TrimSuffix(c.BaseURL, "/") + "/password/reset?token={token}"
+	}
+
+	c.BaseURL = strings.TrimSuffix(c.BaseURL, "/")
+
+	if err := c.Validate(); err != nil {
+		p = append(p, err.(Problems)...)
+	}
+
+	return c, p.err()
+}
+
+// readFile decodes the YAML or TOML file into the map
+func readFile(path string) (map[string]interface{}, error) {
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+
+	switch filepath.Ext(path) {
+	case ".toml":
+		err = toml.Unmarshal(b, &values)
+	default:
+		err = yaml.Unmarshal(b, &values)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %v", path, err)
+	}
+
+	return values, nil
+}
+
+// fromFile sets the values of the file to the fields of v by the config tags.
+// prefix is the keys of the parent sections like "db.".
+func fromFile(v reflect.Value, values map[string]interface{}, prefix string) Problems {
+	var p Problems
+
+	known := map[string]bool{}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		key := field.Tag.Get("config")
+		known[key] = true
+
+		value, ok := values[key]
+
+		if !ok {
+			continue
+		}
+
+		if field.Type.Kind() == reflect.Struct {
+			section, ok := toMap(value)
+
+			if !ok {
+				p.add(fmt.Sprintf("%s%s must be a section", prefix, key))
+				continue
+			}
+
+			p = append(p, fromFile(v.Field(i), section, prefix+key+".")...)
+			continue
+		}
+
+		if err := set(v.Field(i), value); err != nil {
+			p.add(fmt.Sprintf("%s%s %v", prefix, key, err))
+		}
+	}
+
+	unknown := []string{}
+
+	for key := range values {
+		if !known[key] {
+			unknown = append(unknown, prefix+key)
+		}
+	}
+
+	sort.Strings(unknown)
+
+	for _, key := range unknown {
+		p.add(fmt.Sprintf("%s is unknown", key))
+	}
+
+	return p
+}
+
+// toMap converts the section decoded from YAML or TOML into the map
+func toMap(value interface{}) (map[string]interface{}, bool) {
+	switch m := value.(type) {
+	case map[string]interface{}:
+		return m, true
+	case map[interface{}]interface{}:
+		section := map[string]interface{}{}
+
+		for k, v := range m {
+			section[fmt.Sprint(k)] = v
+		}
+
+		return section, true
+	}
+
+	return nil, false
+}
+
+// fromEnv sets the environment variables to the fields of v by the env tags.
+// The first variable set is used if the tag lists multiple variables separated by comma.
+func fromEnv(v reflect.Value) Problems {
+	var p Problems
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+
+		if field.Type.Kind() == reflect.Struct {
+			p = append(p, fromEnv(v.Field(i))...)
+			continue
+		}
+
+		for _, name := range strings.Split(field.Tag.Get("env"), ",") {
+			value, ok := os.LookupEnv(name)
+
+			if !ok || value == "" {
+				continue
+			}
+
+			if err := set(v.Field(i), value); err != nil {
+				p.add(fmt.Sprintf("%s %v", name, err))
+			}
+
+			break
+		}
+	}
+
+	return p
+}
+
+// set converts the value of the file or the environment variable into the type of the field.
+// Lists are written as comma separated strings in environment variables.
+func set(field reflect.Value, value interface{}) error {
+	if field.Kind() == reflect.Slice {
+		var items []string
+
+		switch v := value.(type) {
+		case []interface{}:
+			for _, item := range v {
+				items = append(items, fmt.Sprint(item))
+			}
+		case string:
+			for _, item := range strings.Split(v, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+		default:
+			return fmt.Errorf("must be a list")
+		}
+
+		field.Set(reflect.ValueOf(items))
+
+		return nil
+	}
+
+	s := fmt.Sprint(value)
+
+	switch field.Interface().(type) {
+	case string:
+		field.SetString(s)
+	case bool:
+		b, err := strconv.ParseBool(s)
+
+		if err != nil {
+			return fmt.Errorf("must be true or false but %q", s)
+		}
+
+		field.SetBool(b)
+	case int:
+		i, err := strconv.Atoi(s)
+
+		if err != nil {
+			return fmt.Errorf("must be an integer but %q", s)
+		}
+
+		field.SetInt(int64(i))
+	case time.Duration:
+		d, err := time.ParseDuration(s)
+
+		if err != nil {
+			return fmt.Errorf("must be a duration like 24h but %q", s)
+		}
+
+		field.SetInt(int64(d))
+	default:
+		return fmt.Errorf("has unsupported type %s", field.Type())
+	}
+
+	return nil
+}
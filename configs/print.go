@@ -0,0 +1,57 @@
+package configs
+
+import (
+	"io"
+	"reflect"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// redacted replaces the secrets set in the printed config
+const redacted = "[REDACTED]"
+
+// Print writes the config to w in YAML which can be loaded by Load.
+// Secrets are replaced with [REDACTED] so that the output can be shared.
+func Print(w io.Writer, c Config) error {
+	b, err := yaml.Marshal(toMapSlice(reflect.ValueOf(c)))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(b)
+
+	return err
+}
+
+// toMapSlice converts the struct into the ordered map by the config tags
+func toMapSlice(v reflect.Value) yaml.MapSlice {
+	m := yaml.MapSlice{}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		var value interface{}
+
+		switch f := v.Field(i).Interface().(type) {
+		case time.Duration:
+			value = f.String()
+		case string:
+			value = f
+
+			if field.Tag.Get("secret") == "true" && f != "" {
+				value = redacted
+			}
+		default:
+			value = f
+
+			if field.Type.Kind() == reflect.Struct {
+				value = toMapSlice(v.Field(i))
+			}
+		}
+
+		m = append(m, yaml.MapItem{Key: field.Tag.Get("config"), Value: value})
+	}
+
+	return m
+}
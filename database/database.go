@@ -0,0 +1,105 @@
+// Package database runs multi-step writes in transactions and passes the
+// transaction in progress through the context
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+type contextKey struct {
+	name string
+}
+
+var txCtxKey = &contextKey{name: "tx"}
+
+// maxRetries is the number of times a transaction is retried on deadlock
+const maxRetries = 3
+
+// retryInterval is multiplied by the attempt count before retrying
+const retryInterval = 10 * time.Millisecond
+
+// MySQL error numbers which are resolved by retrying the transaction
+const (
+	errLockWaitTimeout = 1205
+	errDeadlock        = 1213
+)
+
+// Executor returns the transaction running in the context if any, otherwise db.
+// Helpers which may be called inside Transaction should write with it.
+func Executor(ctx context.Context, db *sql.DB) boil.ContextExecutor {
+	if tx, ok := ctx.Value(txCtxKey).(*sql.Tx); ok {
+		return tx
+	}
+
+	return db
+}
+
+// Transaction runs fn in a transaction of db which is committed if fn returns nil,
+// otherwise rolled back. The whole function is retried on deadlock, so fn must
+// not have side effects other than the writes to tx, like publishing events or
+// sending emails. Such effects should follow Transaction, or join tx through
+// Executor like the mails queued by mailqueue.Queue.
+//
+// Nested calls join the transaction of the outer call.
+func Transaction(ctx context.Context, db *sql.DB, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	if tx, ok := ctx.Value(txCtxKey).(*sql.Tx); ok {
+		return fn(ctx, tx)
+	}
+
+	var err error
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt) * retryInterval):
+			}
+		}
+
+		err = run(ctx, db, fn)
+
+		if !isRetryable(err) {
+			return err
+		}
+	}
+
+	return err
+}
+
+// run runs fn once in a new transaction
+func run(ctx context.Context, db *sql.DB, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txCtxKey, tx), tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// isRetryable reports whether the transaction failed by a conflict with other transactions.
+// The errors of sqlboiler are wrapped, so the cause is checked.
+func isRetryable(err error) bool {
+	e, ok := errors.Cause(err).(*mysql.MySQLError)
+
+	return ok && (e.Number == errDeadlock || e.Number == errLockWaitTimeout)
+}
@@ -1,10 +1,20 @@
 //go:generate go run github.com/vektah/dataloaden -keys int github.com/shufo/go-graphql-boilerplate/models.User
+//go:generate go run github.com/vektah/dataloaden -keys int github.com/shufo/go-graphql-boilerplate/models.AuthenticationProvider
+//go:generate go run github.com/vektah/dataloaden -keys int github.com/shufo/go-graphql-boilerplate/models.PasswordReset
+//go:generate go run github.com/vektah/dataloaden -keys int github.com/shufo/go-graphql-boilerplate/models.Profile
+//go:generate go run github.com/vektah/dataloaden -keys int -slice github.com/shufo/go-graphql-boilerplate/models.AuthenticationProvider
+//go:generate go run github.com/vektah/dataloaden -keys int -slice github.com/shufo/go-graphql-boilerplate/models.Role
+//go:generate go run github.com/vektah/dataloaden -keys int -slice github.com/shufo/go-graphql-boilerplate/models.AuthToken
+//go:generate go run github.com/vektah/dataloaden -keys int -slice github.com/shufo/go-graphql-boilerplate/models.PasswordReset
 
 package dataloader
 
 import (
 	"context"
+	"database/sql"
 	"net/http"
+
+	"github.com/shufo/go-graphql-boilerplate/apperror"
 )
 
 type contextKey struct {
@@ -14,21 +24,68 @@ type contextKey struct {
 var UserLoaderKey = &contextKey{name: "userLoader"}
 
 type loaders struct {
-	UserByTodo *UserLoader
+	UserByID                   userByIDLoader
+	AuthenticationProviderByID authenticationProviderByIDLoader
+	PasswordResetByID          passwordResetByIDLoader
+	ProfileByUserID            profileByUserIDLoader
+
+	// loaders of has-many relations
+	AuthenticationProvidersByUserID authenticationProvidersByUserIDLoader
+	RolesByUserID                   rolesByUserIDLoader
+	AuthTokensByUserID              authTokensByUserIDLoader
+	PasswordResetsByProviderID      passwordResetsByProviderIDLoader
 }
 
-func DataloaderMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ldrs := loaders{}
-		ldrs.UserByTodo = NewUserLoader(NewUserLoaderConfig(r))
+// DataloaderMiddleware puts the loaders fetching from db in the context of the request
+func DataloaderMiddleware(db *sql.DB) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			var (
+				userByIDConfig                        UserLoaderConfig
+				authenticationProviderByIDConfig      AuthenticationProviderLoaderConfig
+				passwordResetByIDConfig               PasswordResetLoaderConfig
+				profileByUserIDConfig                 ProfileLoaderConfig
+				authenticationProvidersByUserIDConfig AuthenticationProviderSliceLoaderConfig
+				rolesByUserIDConfig                   RoleSliceLoaderConfig
+				authTokensByUserIDConfig              AuthTokenSliceLoaderConfig
+				passwordResetsByProviderIDConfig      PasswordResetSliceLoaderConfig
+			)
+
+			newConfig(ctx, db, &userByIDConfig, usersByID)
+			newConfig(ctx, db, &authenticationProviderByIDConfig, authenticationProvidersByID)
+			newConfig(ctx, db, &passwordResetByIDConfig, passwordResetsByID)
+			newConfig(ctx, db, &profileByUserIDConfig, profilesByUserID)
+			newConfig(ctx, db, &authenticationProvidersByUserIDConfig, authenticationProvidersByUserID)
+			newConfig(ctx, db, &rolesByUserIDConfig, rolesByUserID)
+			newConfig(ctx, db, &authTokensByUserIDConfig, authTokensByUserID)
+			newConfig(ctx, db, &passwordResetsByProviderIDConfig, passwordResetsByProviderID)
 
-		ctx := context.WithValue(r.Context(), UserLoaderKey, ldrs)
+			ldrs := loaders{
+				UserByID:                        userByIDLoader{NewUserLoader(userByIDConfig)},
+				AuthenticationProviderByID:      authenticationProviderByIDLoader{NewAuthenticationProviderLoader(authenticationProviderByIDConfig)},
+				PasswordResetByID:               passwordResetByIDLoader{NewPasswordResetLoader(passwordResetByIDConfig)},
+				ProfileByUserID:                 profileByUserIDLoader{NewProfileLoader(profileByUserIDConfig)},
+				AuthenticationProvidersByUserID: authenticationProvidersByUserIDLoader{NewAuthenticationProviderSliceLoader(authenticationProvidersByUserIDConfig)},
+				RolesByUserID:                   rolesByUserIDLoader{NewRoleSliceLoader(rolesByUserIDConfig)},
+				AuthTokensByUserID:              authTokensByUserIDLoader{NewAuthTokenSliceLoader(authTokensByUserIDConfig)},
+				PasswordResetsByProviderID:      passwordResetsByProviderIDLoader{NewPasswordResetSliceLoader(passwordResetsByProviderIDConfig)},
+			}
 
-		r = r.WithContext(ctx)
-		next.ServeHTTP(w, r)
-	})
+			ctx = context.WithValue(ctx, UserLoaderKey, ldrs)
+
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
 }
 
 func CtxLoaders(ctx context.Context) loaders {
 	return ctx.Value(UserLoaderKey).(loaders)
 }
+
+// notFound returns the error for the key which doesn't exist
+func notFound(ctx context.Context) error {
+	return apperror.New(ctx, apperror.CodeNotFound, "not_found")
+}
@@ -0,0 +1,136 @@
+package dataloader_test
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/shufo/go-graphql-boilerplate/dataloader"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/shufo/go-graphql-boilerplate/translations"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/text/language"
+)
+
+type DataloaderSuite struct {
+	suite.Suite
+	db       *sql.DB
+	fixtures *testfixtures.Context
+}
+
+func (suite *DataloaderSuite) SetupSuite() {
+	suite.db = testutils.PrepareDB()
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *DataloaderSuite) TearDownSuite() {
+	suite.db.Close()
+}
+
+func (suite *DataloaderSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// context returns the context of a request passed through the middleware
+func (suite *DataloaderSuite) context() context.Context {
+	bundle := &i18n.Bundle{DefaultLanguage: language.English}
+	bundle.AddMessages(language.English, &i18n.Message{ID: "not_found", Other: "not found"})
+
+	var ctx context.Context
+
+	h := dataloader.DataloaderMiddleware(suite.db)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	}))
+
+	r := httptest.NewRequest("POST", "/query", nil)
+	r = r.WithContext(context.WithValue(r.Context(), translations.I18nCtxKey, i18n.NewLocalizer(bundle)))
+	h.ServeHTTP(httptest.NewRecorder(), r)
+
+	return ctx
+}
+
+// Every loader returns the rows of the keys
+func (suite *DataloaderSuite) TestLoad() {
+	ldrs := dataloader.CtxLoaders(suite.context())
+
+	u, err := ldrs.UserByID.Load(1)
+	suite.Require().NoError(err)
+	suite.Equal(1, u.ID)
+
+	ap, err := ldrs.AuthenticationProviderByID.Load(1)
+	suite.Require().NoError(err)
+	suite.Equal(1, ap.ID)
+
+	pr, err := ldrs.PasswordResetByID.Load(1)
+	suite.Require().NoError(err)
+	suite.Equal(1, pr.ID)
+
+	aps, err := ldrs.AuthenticationProvidersByUserID.Load(1)
+	suite.Require().NoError(err)
+	suite.Require().Len(aps, 1)
+	suite.Equal(1, aps[0].UserID)
+
+	roles, err := ldrs.RolesByUserID.Load(1)
+	suite.Require().NoError(err)
+	suite.Require().Len(roles, 1)
+	suite.Equal(1, roles[0].ID)
+
+	tokens, err := ldrs.AuthTokensByUserID.Load(1)
+	suite.Require().NoError(err)
+	suite.Require().Len(tokens, 1)
+	suite.Equal(1, tokens[0].UserID)
+
+	prs, err := ldrs.PasswordResetsByProviderID.Load(1)
+	suite.Require().NoError(err)
+	suite.NotEmpty(prs)
+
+	for _, pr := range prs {
+		suite.Equal(1, pr.AuthenticationProviderID)
+	}
+}
+
+// The keys without rows are not found by the loaders of a row, and empty by the loaders of slices
+func (suite *DataloaderSuite) TestLoadMissing() {
+	ldrs := dataloader.CtxLoaders(suite.context())
+
+	u, err := ldrs.UserByID.Load(9999)
+	suite.Nil(u)
+	suite.EqualError(err, "input: not found")
+
+	p, err := ldrs.ProfileByUserID.Load(1)
+	suite.Nil(p)
+	suite.Error(err)
+
+	roles, err := ldrs.RolesByUserID.Load(9999)
+	suite.NoError(err)
+	suite.NotNil(roles)
+	suite.Empty(roles)
+}
+
+// Keys loaded together are fetched in a batch
+func (suite *DataloaderSuite) TestLoadAll() {
+	ldrs := dataloader.CtxLoaders(suite.context())
+
+	users, errs := ldrs.UserByID.LoadAll([]int{2, 9999, 1})
+	suite.Require().Len(users, 3)
+	suite.Equal(2, users[0].ID)
+	suite.Nil(users[1])
+	suite.Error(errs[1])
+	suite.Equal(1, users[2].ID)
+}
+
+func TestDataloaderSuite(t *testing.T) {
+	suite.Run(t, new(DataloaderSuite))
+}
@@ -0,0 +1,213 @@
+package dataloader
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"time"
+
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// the batch settings shared by all loaders
+const (
+	maxBatch = 100
+	wait     = 1 * time.Millisecond
+)
+
+// query fetches the rows of a loader by the keys
+type query struct {
+	metrics *loaderMetrics
+	// rows returns the slice of the rows of the keys
+	rows func(ctx context.Context, db *sql.DB, keys []interface{}) (interface{}, error)
+	// index returns the key of the row and the loaded value. The row is skipped if ok is false.
+	index func(row interface{}) (key int, value interface{}, ok bool)
+}
+
+var (
+	usersByID = query{
+		metrics: userByIDMetrics,
+		rows: func(ctx context.Context, db *sql.DB, keys []interface{}) (interface{}, error) {
+			return models.Users(qm.WhereIn("id in ?", keys...)).All(ctx, db)
+		},
+		index: func(row interface{}) (int, interface{}, bool) {
+			u := row.(*models.User)
+			return u.ID, u, true
+		},
+	}
+
+	authenticationProvidersByID = query{
+		metrics: authenticationProviderByIDMetrics,
+		rows: func(ctx context.Context, db *sql.DB, keys []interface{}) (interface{}, error) {
+			return models.AuthenticationProviders(qm.WhereIn("id in ?", keys...)).All(ctx, db)
+		},
+		index: func(row interface{}) (int, interface{}, bool) {
+			ap := row.(*models.AuthenticationProvider)
+			return ap.ID, ap, true
+		},
+	}
+
+	passwordResetsByID = query{
+		metrics: passwordResetByIDMetrics,
+		rows: func(ctx context.Context, db *sql.DB, keys []interface{}) (interface{}, error) {
+			return models.PasswordResets(qm.WhereIn("id in ?", keys...)).All(ctx, db)
+		},
+		index: func(row interface{}) (int, interface{}, bool) {
+			pr := row.(*models.PasswordReset)
+			return pr.ID, pr, true
+		},
+	}
+
+	profilesByUserID = query{
+		metrics: profileByUserIDMetrics,
+		rows: func(ctx context.Context, db *sql.DB, keys []interface{}) (interface{}, error) {
+			return models.Profiles(qm.WhereIn("user_id in ?", keys...)).All(ctx, db)
+		},
+		index: func(row interface{}) (int, interface{}, bool) {
+			p := row.(*models.Profile)
+			return p.UserID, p, true
+		},
+	}
+
+	authenticationProvidersByUserID = query{
+		metrics: authenticationProvidersByUserIDMetrics,
+		rows: func(ctx context.Context, db *sql.DB, keys []interface{}) (interface{}, error) {
+			return models.AuthenticationProviders(qm.WhereIn("user_id in ?", keys...)).All(ctx, db)
+		},
+		index: func(row interface{}) (int, interface{}, bool) {
+			ap := row.(*models.AuthenticationProvider)
+			return ap.UserID, ap, true
+		},
+	}
+
+	// roles granted in organizations are included
+	rolesByUserID = query{
+		metrics: rolesByUserIDMetrics,
+		rows: func(ctx context.Context, db *sql.DB, keys []interface{}) (interface{}, error) {
+			return models.UserRoles(qm.WhereIn("user_id in ?", keys...), qm.Load("Role")).All(ctx, db)
+		},
+		index: func(row interface{}) (int, interface{}, bool) {
+			ur := row.(*models.UserRole)
+
+			if ur.R == nil || ur.R.Role == nil {
+				return 0, nil, false
+			}
+
+			return ur.UserID, ur.R.Role, true
+		},
+	}
+
+	authTokensByUserID = query{
+		metrics: authTokensByUserIDMetrics,
+		rows: func(ctx context.Context, db *sql.DB, keys []interface{}) (interface{}, error) {
+			return models.AuthTokens(qm.WhereIn("user_id in ?", keys...)).All(ctx, db)
+		},
+		index: func(row interface{}) (int, interface{}, bool) {
+			t := row.(*models.AuthToken)
+			return t.UserID, t, true
+		},
+	}
+
+	passwordResetsByProviderID = query{
+		metrics: passwordResetsByProviderIDMetrics,
+		rows: func(ctx context.Context, db *sql.DB, keys []interface{}) (interface{}, error) {
+			return models.PasswordResets(qm.WhereIn("authentication_provider_id in ?", keys...)).All(ctx, db)
+		},
+		index: func(row interface{}) (int, interface{}, bool) {
+			pr := row.(*models.PasswordReset)
+			return pr.AuthenticationProviderID, pr, true
+		},
+	}
+)
+
+// fetch runs the query of the keys and returns the values grouped by key in the order of the keys
+func (q query) fetch(ctx context.Context, db *sql.DB, keys []int) ([][]interface{}, error) {
+	q.metrics.batch(len(keys))
+
+	s := make([]interface{}, len(keys))
+
+	for i, v := range keys {
+		s[i] = v
+	}
+
+	rows, err := q.rows(ctx, db, s)
+
+	if err != nil {
+		q.metrics.errors.Add(1)
+		return nil, err
+	}
+
+	grouped := map[int][]interface{}{}
+	v := reflect.ValueOf(rows)
+
+	for i := 0; i < v.Len(); i++ {
+		if key, value, ok := q.index(v.Index(i).Interface()); ok {
+			grouped[key] = append(grouped[key], value)
+		}
+	}
+
+	results := make([][]interface{}, len(keys))
+
+	for i, key := range keys {
+		results[i] = grouped[key]
+	}
+
+	return results, nil
+}
+
+// newConfig fills the generated loader config which config points to, like *UserLoaderConfig,
+// with the batch settings and the fetch of the query in ctx.
+//
+// The generated configs differ only in the type of the values, so Fetch is made by reflection.
+// Loaders of slices return the values of each key, which are empty if the key has none.
+// The other loaders return the value of each key, or NotFound errors for the keys without values.
+func newConfig(ctx context.Context, db *sql.DB, config interface{}, q query) {
+	c := reflect.ValueOf(config).Elem()
+	c.FieldByName("MaxBatch").SetInt(maxBatch)
+	c.FieldByName("Wait").Set(reflect.ValueOf(wait))
+
+	fetch := c.FieldByName("Fetch")
+	resultsType := fetch.Type().Out(0)
+	valueType := resultsType.Elem()
+	isSlice := valueType.Kind() == reflect.Slice
+
+	fetch.Set(reflect.MakeFunc(fetch.Type(), func(args []reflect.Value) []reflect.Value {
+		keys := args[0].Interface().([]int)
+		grouped, err := q.fetch(ctx, db, keys)
+
+		if err != nil {
+			return []reflect.Value{reflect.Zero(resultsType), reflect.ValueOf([]error{err})}
+		}
+
+		results := reflect.MakeSlice(resultsType, len(keys), len(keys))
+
+		if isSlice {
+			for i, values := range grouped {
+				s := reflect.MakeSlice(valueType, 0, len(values))
+
+				for _, v := range values {
+					s = reflect.Append(s, reflect.Indirect(reflect.ValueOf(v)))
+				}
+
+				results.Index(i).Set(s)
+			}
+
+			return []reflect.Value{results, reflect.Zero(fetch.Type().Out(1))}
+		}
+
+		errs := make([]error, len(keys))
+
+		for i, values := range grouped {
+			if len(values) == 0 {
+				q.metrics.notFound.Add(1)
+				errs[i] = notFound(ctx)
+				continue
+			}
+
+			results.Index(i).Set(reflect.ValueOf(values[0]))
+		}
+
+		return []reflect.Value{results, reflect.ValueOf(errs)}
+	}))
+}
@@ -0,0 +1,189 @@
+package dataloader
+
+import (
+	"expvar"
+	"sync"
+
+	"github.com/shufo/go-graphql-boilerplate/models"
+)
+
+// metrics of each loader are published under "dataloader" in /debug/vars
+var metrics = expvar.NewMap("dataloader")
+
+// batchSizeBuckets are the upper bounds of the batch size histogram
+var batchSizeBuckets = []struct {
+	max   int
+	label string
+}{
+	{1, "1"},
+	{10, "2-10"},
+	{50, "11-50"},
+	{100, "51-100"},
+}
+
+// loaderMetrics counts the loads and the fetched batches of a loader.
+// Loads which are not fetched are served from the cache or deduplicated in
+// the batch, so cache_hits is the number of loads minus the fetched keys.
+type loaderMetrics struct {
+	loads      expvar.Int
+	batches    expvar.Int
+	keys       expvar.Int
+	errors     expvar.Int
+	notFound   expvar.Int
+	batchSizes expvar.Map
+
+	mu           sync.Mutex
+	maxBatchSize expvar.Int
+}
+
+func newLoaderMetrics(name string) *loaderMetrics {
+	m := &loaderMetrics{}
+	m.batchSizes.Init()
+
+	for _, b := range batchSizeBuckets {
+		m.batchSizes.Add(b.label, 0)
+	}
+
+	v := new(expvar.Map).Init()
+	v.Set("loads", &m.loads)
+	v.Set("batches", &m.batches)
+	v.Set("keys", &m.keys)
+	v.Set("errors", &m.errors)
+	v.Set("not_found", &m.notFound)
+	v.Set("batch_sizes", &m.batchSizes)
+	v.Set("max_batch_size", &m.maxBatchSize)
+	v.Set("cache_hits", expvar.Func(func() interface{} {
+		return m.loads.Value() - m.keys.Value()
+	}))
+
+	metrics.Set(name, v)
+
+	return m
+}
+
+func (m *loaderMetrics) load() {
+	m.loads.Add(1)
+}
+
+// batch records the size of the batch sent to the fetch function
+func (m *loaderMetrics) batch(size int) {
+	m.batches.Add(1)
+	m.keys.Add(int64(size))
+
+	for _, b := range batchSizeBuckets {
+		if size <= b.max {
+			m.batchSizes.Add(b.label, 1)
+			break
+		}
+	}
+
+	m.mu.Lock()
+	if int64(size) > m.maxBatchSize.Value() {
+		m.maxBatchSize.Set(int64(size))
+	}
+	m.mu.Unlock()
+}
+
+var (
+	userByIDMetrics                        = newLoaderMetrics("UserByID")
+	authenticationProviderByIDMetrics      = newLoaderMetrics("AuthenticationProviderByID")
+	passwordResetByIDMetrics               = newLoaderMetrics("PasswordResetByID")
+	profileByUserIDMetrics                 = newLoaderMetrics("ProfileByUserID")
+	authenticationProvidersByUserIDMetrics = newLoaderMetrics("AuthenticationProvidersByUserID")
+	rolesByUserIDMetrics                   = newLoaderMetrics("RolesByUserID")
+	authTokensByUserIDMetrics              = newLoaderMetrics("AuthTokensByUserID")
+	passwordResetsByProviderIDMetrics      = newLoaderMetrics("PasswordResetsByProviderID")
+)
+
+// The loaders below wrap the generated loaders to count the loads, since the
+// cache is looked up inside the generated LoadThunk. LoadAll is not counted.
+
+type userByIDLoader struct{ *UserLoader }
+
+func (l userByIDLoader) Load(key int) (*models.User, error) {
+	return l.LoadThunk(key)()
+}
+
+func (l userByIDLoader) LoadThunk(key int) func() (*models.User, error) {
+	userByIDMetrics.load()
+	return l.UserLoader.LoadThunk(key)
+}
+
+type authenticationProviderByIDLoader struct{ *AuthenticationProviderLoader }
+
+func (l authenticationProviderByIDLoader) Load(key int) (*models.AuthenticationProvider, error) {
+	return l.LoadThunk(key)()
+}
+
+func (l authenticationProviderByIDLoader) LoadThunk(key int) func() (*models.AuthenticationProvider, error) {
+	authenticationProviderByIDMetrics.load()
+	return l.AuthenticationProviderLoader.LoadThunk(key)
+}
+
+type passwordResetByIDLoader struct{ *PasswordResetLoader }
+
+func (l passwordResetByIDLoader) Load(key int) (*models.PasswordReset, error) {
+	return l.LoadThunk(key)()
+}
+
+func (l passwordResetByIDLoader) LoadThunk(key int) func() (*models.PasswordReset, error) {
+	passwordResetByIDMetrics.load()
+	return l.PasswordResetLoader.LoadThunk(key)
+}
+
+type profileByUserIDLoader struct{ *ProfileLoader }
+
+func (l profileByUserIDLoader) Load(key int) (*models.Profile, error) {
+	return l.LoadThunk(key)()
+}
+
+func (l profileByUserIDLoader) LoadThunk(key int) func() (*models.Profile, error) {
+	profileByUserIDMetrics.load()
+	return l.ProfileLoader.LoadThunk(key)
+}
+
+type authenticationProvidersByUserIDLoader struct {
+	*AuthenticationProviderSliceLoader
+}
+
+func (l authenticationProvidersByUserIDLoader) Load(key int) ([]models.AuthenticationProvider, error) {
+	return l.LoadThunk(key)()
+}
+
+func (l authenticationProvidersByUserIDLoader) LoadThunk(key int) func() ([]models.AuthenticationProvider, error) {
+	authenticationProvidersByUserIDMetrics.load()
+	return l.AuthenticationProviderSliceLoader.LoadThunk(key)
+}
+
+type rolesByUserIDLoader struct{ *RoleSliceLoader }
+
+func (l rolesByUserIDLoader) Load(key int) ([]models.Role, error) {
+	return l.LoadThunk(key)()
+}
+
+func (l rolesByUserIDLoader) LoadThunk(key int) func() ([]models.Role, error) {
+	rolesByUserIDMetrics.load()
+	return l.RoleSliceLoader.LoadThunk(key)
+}
+
+type authTokensByUserIDLoader struct{ *AuthTokenSliceLoader }
+
+func (l authTokensByUserIDLoader) Load(key int) ([]models.AuthToken, error) {
+	return l.LoadThunk(key)()
+}
+
+func (l authTokensByUserIDLoader) LoadThunk(key int) func() ([]models.AuthToken, error) {
+	authTokensByUserIDMetrics.load()
+	return l.AuthTokenSliceLoader.LoadThunk(key)
+}
+
+type passwordResetsByProviderIDLoader struct{ *PasswordResetSliceLoader }
+
+func (l passwordResetsByProviderIDLoader) Load(key int) ([]models.PasswordReset, error) {
+	return l.LoadThunk(key)()
+}
+
+func (l passwordResetsByProviderIDLoader) LoadThunk(key int) func() ([]models.PasswordReset, error) {
+	passwordResetsByProviderIDMetrics.load()
+	return l.PasswordResetSliceLoader.LoadThunk(key)
+}
@@ -0,0 +1,353 @@
+package mail
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// cssRule is a rule of a style element with a simple selector which can be inlined
+type cssRule struct {
+	selector     []compoundSelector
+	specificity  [3]int
+	order        int
+	declarations []cssDeclaration
+}
+
+type cssDeclaration struct {
+	property string
+	value    string
+}
+
+// compoundSelector matches an element by the tag, the id and the classes
+type compoundSelector struct {
+	tag     string
+	id      string
+	classes []string
+}
+
+var cssComment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+
+// selectorToken splits a compound selector like "a.b#c" into "a", ".b" and "#c"
+var selectorToken = regexp.MustCompile(`[.#]?[^.#]+`)
+
+// simpleSelector matches the selectors which are inlined: tags, classes and
+// ids combined with descendant combinators. Pseudo classes, attribute
+// selectors and other combinators are left in the style element.
+var simpleSelector = regexp.MustCompile(`^[a-zA-Z0-9_\-.#\s]+$`)
+
+// InlineCSS moves the rules in the style elements of the HTML document to the style
+// attributes of the matching elements, since some mail clients ignore style elements.
+// Rules which can not be inlined like media queries are kept in the style element.
+// Declarations in the style attributes take precedence over the inlined rules.
+func InlineCSS(doc string) (string, error) {
+	root, err := html.Parse(strings.NewReader(doc))
+
+	if err != nil {
+		return "", err
+	}
+
+	rules := []cssRule{}
+
+	for _, style := range findAll(root, atom.Style) {
+		var text bytes.Buffer
+
+		for c := style.FirstChild; c != nil; c = c.NextSibling {
+			text.WriteString(c.Data)
+		}
+
+		inlined, rest := parseCSS(text.String(), len(rules))
+		rules = append(rules, inlined...)
+
+		if strings.TrimSpace(rest) == "" {
+			style.Parent.RemoveChild(style)
+			continue
+		}
+
+		for c := style.FirstChild; c != nil; c = style.FirstChild {
+			style.RemoveChild(c)
+		}
+
+		style.AppendChild(&html.Node{Type: html.TextNode, Data: rest})
+	}
+
+	// more specific rules override less specific rules, then later rules override earlier rules
+	sort.SliceStable(rules, func(i, j int) bool {
+		a, b := rules[i].specificity, rules[j].specificity
+
+		if a != b {
+			return a[0] < b[0] || (a[0] == b[0] && (a[1] < b[1] || (a[1] == b[1] && a[2] < b[2])))
+		}
+
+		return rules[i].order < rules[j].order
+	})
+
+	walk(root, func(n *html.Node) {
+		if n.Type != html.ElementNode {
+			return
+		}
+
+		declarations := []cssDeclaration{}
+
+		for _, r := range rules {
+			if r.matches(n) {
+				declarations = append(declarations, r.declarations...)
+			}
+		}
+
+		if len(declarations) == 0 {
+			return
+		}
+
+		if style, ok := attr(n, "style"); ok {
+			declarations = append(declarations, parseDeclarations(style)...)
+		}
+
+		setAttr(n, "style", formatDeclarations(declarations))
+	})
+
+	var out bytes.Buffer
+
+	if err := html.Render(&out, root); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
+
+// parseCSS returns the rules to be inlined and the CSS text of the others.
+// order is the order of the first rule among all the style elements.
+func parseCSS(css string, order int) ([]cssRule, string) {
+	css = cssComment.ReplaceAllString(css, "")
+
+	rules := []cssRule{}
+	var rest strings.Builder
+
+	for {
+		open := strings.Index(css, "{")
+
+		if open < 0 {
+			break
+		}
+
+		prelude := strings.TrimSpace(css[:open])
+
+		// at-rules like media queries have nested blocks
+		if strings.HasPrefix(prelude, "@") {
+			end := matchingBrace(css, open)
+			rest.WriteString(strings.TrimSpace(css[:end]) + "\n")
+			css = css[end:]
+			continue
+		}
+
+		end := strings.Index(css[open:], "}")
+
+		if end < 0 {
+			break
+		}
+
+		end += open + 1
+		body := css[open+1 : end-1]
+		declarations := parseDeclarations(body)
+
+		for _, selector := range strings.Split(prelude, ",") {
+			selector = strings.TrimSpace(selector)
+
+			if !simpleSelector.MatchString(selector) {
+				rest.WriteString(selector + " {" + body + "}\n")
+				continue
+			}
+
+			rules = append(rules, newCSSRule(selector, order, declarations))
+			order++
+		}
+
+		css = css[end:]
+	}
+
+	return rules, rest.String()
+}
+
+// matchingBrace returns the index after the brace closing the one at open
+func matchingBrace(css string, open int) int {
+	depth := 0
+
+	for i := open; i < len(css); i++ {
+		switch css[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+
+	return len(css)
+}
+
+func newCSSRule(selector string, order int, declarations []cssDeclaration) cssRule {
+	r := cssRule{order: order, declarations: declarations}
+
+	for _, part := range strings.Fields(selector) {
+		c := compoundSelector{}
+
+		for _, token := range selectorToken.FindAllString(part, -1) {
+			switch token[0] {
+			case '.':
+				c.classes = append(c.classes, token[1:])
+				r.specificity[1]++
+			case '#':
+				c.id = token[1:]
+				r.specificity[0]++
+			default:
+				c.tag = strings.ToLower(token)
+				r.specificity[2]++
+			}
+		}
+
+		r.selector = append(r.selector, c)
+	}
+
+	return r
+}
+
+// matches reports whether the element matches the last compound selector
+// and its ancestors match the others in order
+func (r cssRule) matches(n *html.Node) bool {
+	last := len(r.selector) - 1
+
+	if !r.selector[last].matches(n) {
+		return false
+	}
+
+	i := last - 1
+
+	for p := n.Parent; p != nil && i >= 0; p = p.Parent {
+		if p.Type == html.ElementNode && r.selector[i].matches(p) {
+			i--
+		}
+	}
+
+	return i < 0
+}
+
+func (c compoundSelector) matches(n *html.Node) bool {
+	if c.tag != "" && c.tag != n.Data {
+		return false
+	}
+
+	if c.id != "" {
+		if id, _ := attr(n, "id"); id != c.id {
+			return false
+		}
+	}
+
+	class, _ := attr(n, "class")
+	classes := strings.Fields(class)
+
+	for _, want := range c.classes {
+		found := false
+
+		for _, got := range classes {
+			if got == want {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func parseDeclarations(text string) []cssDeclaration {
+	declarations := []cssDeclaration{}
+
+	for _, d := range strings.Split(text, ";") {
+		kv := strings.SplitN(d, ":", 2)
+
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			continue
+		}
+
+		declarations = append(declarations, cssDeclaration{
+			property: strings.ToLower(strings.TrimSpace(kv[0])),
+			value:    strings.TrimSpace(kv[1]),
+		})
+	}
+
+	return declarations
+}
+
+// formatDeclarations writes the last value of each property in the order the properties first appear
+func formatDeclarations(declarations []cssDeclaration) string {
+	values := map[string]string{}
+	properties := []string{}
+
+	for _, d := range declarations {
+		if _, ok := values[d.property]; !ok {
+			properties = append(properties, d.property)
+		}
+
+		values[d.property] = d.value
+	}
+
+	parts := make([]string, len(properties))
+
+	for i, p := range properties {
+		parts[i] = p + ": " + values[p]
+	}
+
+	return strings.Join(parts, "; ") + ";"
+}
+
+func walk(n *html.Node, fn func(*html.Node)) {
+	fn(n)
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walk(c, fn)
+	}
+}
+
+func findAll(root *html.Node, a atom.Atom) []*html.Node {
+	nodes := []*html.Node{}
+
+	walk(root, func(n *html.Node) {
+		if n.Type == html.ElementNode && n.DataAtom == a {
+			nodes = append(nodes, n)
+		}
+	})
+
+	return nodes
+}
+
+func attr(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val, true
+		}
+	}
+
+	return "", false
+}
+
+func setAttr(n *html.Node, key string, val string) {
+	for i, a := range n.Attr {
+		if a.Key == key {
+			n.Attr[i].Val = val
+			return
+		}
+	}
+
+	n.Attr = append(n.Attr, html.Attribute{Key: key, Val: val})
+}
@@ -2,25 +2,11 @@ package mail
 
 import (
 	"fmt"
-	"os"
-
-	//go get -u github.com/aws/aws-sdk-go
-
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/ses"
+	"net/textproto"
+	"strings"
 )
 
 const (
-	// Replace sender@example.com with your "From" address.
-	// This address must be verified with Amazon SES.
-	Sender = "info@example.co.jp"
-
-	// Specify a configuration set. To use a configuration
-	// set, comment the next line and line 92.
-	//ConfigurationSet = "ConfigSet"
-
 	// The character encoding for the email.
 	CharSet = "UTF-8"
 )
@@ -30,6 +16,17 @@ type Mail struct {
 	Subject   string
 	HTMLBody  string
 	TextBody  string
+
+	// IdempotencyKey identifies the mail among retried requests.
+	// Queued mailers send a mail only once per key. It is optional.
+	IdempotencyKey string `json:",omitempty"`
+
+	// Headers are the additional headers like List-Unsubscribe. It is optional.
+	Headers map[string]string `json:",omitempty"`
+
+	// Sensitive marks the mail containing secrets like password reset links.
+	// Queued mailers don't keep the bodies of such mails once they are sent or failed.
+	Sensitive bool `json:",omitempty"`
 }
 
 func New(to string) *Mail {
@@ -48,6 +45,15 @@ func (m *Mail) SetTextBody(body string) {
 	m.TextBody = body
 }
 
+// SetHeader sets the additional header of the mail
+func (m *Mail) SetHeader(name, value string) {
+	if m.Headers == nil {
+		m.Headers = map[string]string{}
+	}
+
+	m.Headers[textproto.CanonicalMIMEHeaderKey(name)] = value
+}
+
 func (m *Mail) Validate() error {
 	if m.HTMLBody == "" {
 		return fmt.Errorf("require mail html body")
@@ -61,93 +67,21 @@ func (m *Mail) Validate() error {
 		return fmt.Errorf("require mail recipient")
 	}
 
-	if m.Subject == "" {
-		return fmt.Errorf("require mail subject")
-	}
-
-	return nil
-}
-
-// Send sends mail with setting
-func (m *Mail) Send() error {
-
-	// validate
-	if err := m.Validate(); err != nil {
-		return err
+	// the recipient is written in the header as it is
+	if strings.ContainsAny(m.Recipient, "\r\n") {
+		return fmt.Errorf("invalid mail recipient")
 	}
 
-	// return if APP_ENV is local
-	if env, found := os.LookupEnv("APP_ENV"); found && env == "local" {
-		return nil
-	}
-	// func main() {
-	// Create a new session in the us-west-2 region.
-	// Replace us-west-2 with the AWS Region you're using for Amazon SES.
-	sess, err := session.NewSession(&aws.Config{
-		Region: aws.String("us-west-2")},
-	)
-
-	// Create an SES session.
-	svc := ses.New(sess)
-	// Recipient := email
-	Recipient := m.Recipient
-
-	// Assemble the email.
-	input := &ses.SendEmailInput{
-		Destination: &ses.Destination{
-			CcAddresses: []*string{},
-			ToAddresses: []*string{
-				aws.String(m.Recipient),
-			},
-		},
-		Message: &ses.Message{
-			Body: &ses.Body{
-				Html: &ses.Content{
-					Charset: aws.String(CharSet),
-					Data:    aws.String(m.HTMLBody),
-				},
-				Text: &ses.Content{
-					Charset: aws.String(CharSet),
-					Data:    aws.String(m.TextBody),
-				},
-			},
-			Subject: &ses.Content{
-				Charset: aws.String(CharSet),
-				Data:    aws.String(m.Subject),
-			},
-		},
-		Source: aws.String(Sender),
-		// Uncomment to use a configuration set
-		//ConfigurationSetName: aws.String(ConfigurationSet),
+	if m.Subject == "" {
+		return fmt.Errorf("require mail subject")
 	}
 
-	// Attempt to send the email.
-	result, err := svc.SendEmail(input)
-
-	// Display error messages if they occur.
-	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ses.ErrCodeMessageRejected:
-				fmt.Println(ses.ErrCodeMessageRejected, aerr.Error())
-			case ses.ErrCodeMailFromDomainNotVerifiedException:
-				fmt.Println(ses.ErrCodeMailFromDomainNotVerifiedException, aerr.Error())
-			case ses.ErrCodeConfigurationSetDoesNotExistException:
-				fmt.Println(ses.ErrCodeConfigurationSetDoesNotExistException, aerr.Error())
-			default:
-				fmt.Println(aerr.Error())
-			}
-		} else {
-			// Print the error, cast err to awserr.Error to get the Code and
-			// Message from an error.
-			fmt.Println(err.Error())
+	// the headers are also written as they are
+	for name, value := range m.Headers {
+		if name == "" || strings.ContainsAny(name, ": \r\n") || strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("invalid mail header %q", name)
 		}
-
-		return err
 	}
 
-	fmt.Println("Email Sent to address: " + Recipient)
-	fmt.Println(result)
-
 	return nil
 }
@@ -0,0 +1,48 @@
+package mail
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrSuppressed is returned when sending to the address which bounced or complained
+var ErrSuppressed = errors.New("mail: recipient is suppressed")
+
+// Mailer sends mails.
+//
+// The mailers called in database.Transaction must join the transaction running in ctx
+// like mailqueue.Queue, so that the mail is kept only if the transaction is committed
+// and is queued once however many times the transaction is retried. The transports
+// which send mails immediately like SES and SMTP must not be called in transactions.
+type Mailer interface {
+	Send(ctx context.Context, m *Mail) error
+}
+
+// NewMailer returns the Mailer selected by the driver of the config.
+//
+//   - "ses" sends by Amazon SES in SESRegion
+//   - "smtp" sends to SMTPHost:SMTPPort with SMTPUsername and SMTPPassword
+//   - "outbox" keeps mails in memory and writes them to OutboxDir if it is set
+//   - otherwise mails are only logged
+func NewMailer(config configs.Mail, logger logrus.FieldLogger) Mailer {
+	switch config.Driver {
+	case "ses":
+		return NewSES(SESConfig{From: config.From, Region: config.SESRegion})
+	case "smtp":
+		return NewSMTP(SMTPConfig{
+			From:     config.From,
+			Host:     config.SMTPHost,
+			Port:     config.SMTPPort,
+			Username: config.SMTPUsername,
+			Password: config.SMTPPassword,
+			Timeout:  config.SMTPTimeout,
+		})
+	case "outbox":
+		return NewOutbox(config.OutboxDir)
+	default:
+		return NewLog(logger)
+	}
+}
@@ -0,0 +1,93 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Outbox keeps mails instead of delivering them for local development and tests.
+// If Dir is set, each mail is also written there as a JSON file so that other
+// processes can read it with ReadOutbox.
+type Outbox struct {
+	Dir string
+
+	mu    sync.Mutex
+	mails []*Mail
+}
+
+// NewOutbox returns the outbox writing mails to dir. Mails are kept only in memory if dir is empty.
+func NewOutbox(dir string) *Outbox {
+	return &Outbox{Dir: dir}
+}
+
+// Send keeps the copy of the mail
+func (o *Outbox) Send(ctx context.Context, m *Mail) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	cpy := *m
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.mails = append(o.mails, &cpy)
+
+	if o.Dir == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(cpy)
+
+	if err != nil {
+		return err
+	}
+
+	// file names are sorted in the order of sending
+	name := fmt.Sprintf("%d-%04d.json", time.Now().UnixNano(), len(o.mails))
+
+	return ioutil.WriteFile(filepath.Join(o.Dir, name), b, 0644)
+}
+
+// Mails returns the mails sent so far
+func (o *Outbox) Mails() []*Mail {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return append([]*Mail{}, o.mails...)
+}
+
+// ReadOutbox returns the mails written in dir in the order of sending
+func ReadOutbox(dir string) ([]*Mail, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+
+	mails := make([]*Mail, len(files))
+
+	for i, f := range files {
+		b, err := ioutil.ReadFile(f)
+
+		if err != nil {
+			return nil, err
+		}
+
+		mails[i] = &Mail{}
+
+		if err := json.Unmarshal(b, mails[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return mails, nil
+}
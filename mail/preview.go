@@ -0,0 +1,87 @@
+package mail
+
+import (
+	"html/template"
+	"net/http"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+var previewIndex = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="UTF-8"><title>Mail previews</title></head>
+<body>
+<h1>Mail previews</h1>
+{{range $locale, $names := .}}
+<h2>{{$locale}}</h2>
+<ul>
+{{range $names}}<li><a href="{{$locale}}/{{.}}">{{.}}</a> (<a href="{{$locale}}/{{.}}?format=text">text</a>)</li>
+{{end}}
+</ul>
+{{end}}
+<p>Template variables are given by query parameters like ?ResetLink=https://example.jp/</p>
+<p>The unsubscribe link is rendered with ?UnsubscribeURL=https://example.jp/unsubscribe</p>
+</body>
+</html>
+`))
+
+// PreviewHandler serves the rendered mails at /<locale>/<name> and the list of them at /.
+// The template data is taken from the query parameters and the text body is shown
+// with ?format=text. The footer shows the link to ?UnsubscribeURL if it is given.
+// Mount it with the prefix stripped only in non-production environments.
+func (t *Templates) PreviewHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.Trim(r.URL.Path, "/")
+
+		if path == "" {
+			w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+			previewIndex.Execute(w, t.Names())
+			return
+		}
+
+		parts := strings.Split(path, "/")
+
+		if len(parts) != 2 {
+			http.NotFound(w, r)
+			return
+		}
+
+		tag, err := language.Parse(parts[0])
+
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		data := map[string]interface{}{}
+
+		for key, values := range r.URL.Query() {
+			if key != "format" && key != "UnsubscribeURL" && len(values) > 0 {
+				data[key] = values[0]
+			}
+		}
+
+		var m *Mail
+
+		if u := r.URL.Query().Get("UnsubscribeURL"); u != "" {
+			m, err = t.RenderUnsubscribable(tag, parts[1], data, u)
+		} else {
+			m, err = t.Render(tag, parts[1], data)
+		}
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		if r.URL.Query().Get("format") == "text" {
+			w.Header().Set("Content-Type", "text/plain; charset=UTF-8")
+			w.Write([]byte("Subject: " + m.Subject + "\n\n" + m.TextBody))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+		w.Write([]byte(m.HTMLBody))
+	})
+}
@@ -0,0 +1,83 @@
+package mail
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ses"
+)
+
+// SESConfig is the setting of SES mailer
+type SESConfig struct {
+	// From must be verified with Amazon SES
+	From   string
+	Region string
+}
+
+// SES sends mails by Amazon SES
+type SES struct {
+	client *ses.SES
+	from   string
+}
+
+// NewSES returns the mailer for the region. Credentials are taken from the environment.
+func NewSES(c SESConfig) *SES {
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(c.Region)}))
+
+	return &SES{client: ses.New(sess), from: c.From}
+}
+
+// Send sends the mail with HTML and text bodies.
+// Mails with additional headers are sent as raw messages since SendEmail can not set them.
+func (s *SES) Send(ctx context.Context, m *Mail) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	if len(m.Headers) > 0 {
+		return s.sendRaw(ctx, m)
+	}
+
+	_, err := s.client.SendEmailWithContext(ctx, &ses.SendEmailInput{
+		Destination: &ses.Destination{
+			ToAddresses: []*string{aws.String(m.Recipient)},
+		},
+		Message: &ses.Message{
+			Body: &ses.Body{
+				Html: &ses.Content{
+					Charset: aws.String(CharSet),
+					Data:    aws.String(m.HTMLBody),
+				},
+				Text: &ses.Content{
+					Charset: aws.String(CharSet),
+					Data:    aws.String(m.TextBody),
+				},
+			},
+			Subject: &ses.Content{
+				Charset: aws.String(CharSet),
+				Data:    aws.String(m.Subject),
+			},
+		},
+		Source: aws.String(s.from),
+	})
+
+	return err
+}
+
+func (s *SES) sendRaw(ctx context.Context, m *Mail) error {
+	msg, err := m.Message(s.from, time.Now())
+
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.SendRawEmailWithContext(ctx, &ses.SendRawEmailInput{
+		Destinations: []*string{aws.String(m.Recipient)},
+		RawMessage:   &ses.RawMessage{Data: msg},
+		Source:       aws.String(s.from),
+	})
+
+	return err
+}
@@ -0,0 +1,139 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SMTPConfig is the setting of SMTP mailer
+type SMTPConfig struct {
+	From string
+	Host string
+	Port int
+	// Username and Password are used for PLAIN authentication if Username is set
+	Username string
+	Password string
+	// Timeout is the limit of connecting and of each read and write. The deadline of
+	// the context is used if it is earlier. defaultSMTPTimeout is used if it is 0.
+	Timeout time.Duration
+}
+
+// defaultSMTPTimeout keeps a stalled server from blocking the sender forever
+const defaultSMTPTimeout = 30 * time.Second
+
+// SMTP sends mails to the SMTP server.
+// The connection is upgraded by STARTTLS if the server supports it.
+type SMTP struct {
+	config SMTPConfig
+}
+
+// NewSMTP returns the mailer for the server
+func NewSMTP(c SMTPConfig) *SMTP {
+	return &SMTP{config: c}
+}
+
+// Send sends the mail in a new connection
+func (s *SMTP) Send(ctx context.Context, m *Mail) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	msg, err := m.Message(s.config.From, time.Now())
+
+	if err != nil {
+		return err
+	}
+
+	timeout := s.config.Timeout
+
+	if timeout <= 0 {
+		timeout = defaultSMTPTimeout
+	}
+
+	d := net.Dialer{Timeout: timeout}
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port)))
+
+	if err != nil {
+		return err
+	}
+
+	c, err := smtp.NewClient(&deadlineConn{Conn: conn, ctx: ctx, timeout: timeout}, s.config.Host)
+
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	// the connection is closed with the client
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: s.config.Host}); err != nil {
+			return err
+		}
+	}
+
+	// PlainAuth refuses to send the password without TLS except to localhost
+	if s.config.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(s.config.From); err != nil {
+		return err
+	}
+
+	if err := c.Rcpt(m.Recipient); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// deadlineConn sets the deadline before each read and write, so that a stalled
+// server fails the call after the timeout or the deadline of the context
+type deadlineConn struct {
+	net.Conn
+	ctx     context.Context
+	timeout time.Duration
+}
+
+func (c *deadlineConn) Read(b []byte) (int, error) {
+	c.extend()
+	return c.Conn.Read(b)
+}
+
+func (c *deadlineConn) Write(b []byte) (int, error) {
+	c.extend()
+	return c.Conn.Write(b)
+}
+
+// extend sets the deadline to the timeout from now or the deadline of the context if it is earlier
+func (c *deadlineConn) extend() {
+	deadline := time.Now().Add(c.timeout)
+
+	if d, ok := c.ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	c.Conn.SetDeadline(deadline)
+}
@@ -0,0 +1,54 @@
+package mail_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/stretchr/testify/suite"
+)
+
+type SMTPSuite struct {
+	suite.Suite
+}
+
+// The server which accepts the connection and never responds fails the mail after the timeout
+func (suite *SMTPSuite) TestStalledServer() {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+	defer l.Close()
+
+	// the connections are kept open without the greeting
+	go func() {
+		for {
+			conn, err := l.Accept()
+
+			if err != nil {
+				return
+			}
+
+			defer conn.Close()
+		}
+	}()
+
+	addr := l.Addr().(*net.TCPAddr)
+	smtp := mail.NewSMTP(mail.SMTPConfig{From: "info@example.co.jp", Host: "127.0.0.1", Port: addr.Port, Timeout: 50 * time.Millisecond})
+
+	m := mail.New("success@simulator.amazonses.com")
+	m.SetSubject("subject")
+	m.SetHTMLBody("<p>body</p>")
+	m.SetTextBody("body")
+
+	started := time.Now()
+	err = smtp.Send(context.Background(), m)
+
+	suite.Error(err)
+	suite.True(err.(net.Error).Timeout())
+	suite.True(time.Since(started) < time.Second)
+}
+
+func TestSMTPSuite(t *testing.T) {
+	suite.Run(t, new(SMTPSuite))
+}
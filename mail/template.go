@@ -0,0 +1,253 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"html/template"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gobuffalo/packr"
+	"github.com/shufo/go-graphql-boilerplate/translations"
+	"golang.org/x/text/language"
+)
+
+// DefaultLocale is used when the templates are not translated to the requested language
+const DefaultLocale = "en"
+
+// Templates renders mails from the templates bundled in mail/templates.
+//
+//   - layouts/*.html and partials/*.html are shared by all the languages
+//   - <locale>/_*.html are the partials of the language like the footer, which gets
+//     the UnsubscribeURL of the mail instead of the data
+//   - <locale>/<name>.html is the mail of the name which defines "subject" and "content"
+//
+// Mails are rendered in the "layout" template. The text body is generated
+// from the HTML body and the styles are inlined for mail clients ignoring
+// the style element.
+type Templates struct {
+	// mails[locale][name]
+	mails map[string]map[string]*template.Template
+}
+
+// layoutData is passed to the layout and the footer. The mail template gets Data.
+type layoutData struct {
+	Lang string
+	Data interface{}
+	// UnsubscribeURL is shown in the footer of the mails users can opt out of
+	UnsubscribeURL string
+}
+
+var templateFuncs = template.FuncMap{
+	// dict builds the map from the pairs of key and value to pass multiple values to partials
+	"dict": func(pairs ...interface{}) (map[string]interface{}, error) {
+		if len(pairs)%2 != 0 {
+			return nil, fmt.Errorf("dict requires pairs of key and value")
+		}
+
+		m := make(map[string]interface{}, len(pairs)/2)
+
+		for i := 0; i < len(pairs); i += 2 {
+			key, ok := pairs[i].(string)
+
+			if !ok {
+				return nil, fmt.Errorf("dict key must be string: %v", pairs[i])
+			}
+
+			m[key] = pairs[i+1]
+		}
+
+		return m, nil
+	},
+}
+
+// NewTemplates parses the templates bundled with the binary
+func NewTemplates() (*Templates, error) {
+	return LoadTemplates(packr.NewBox("./templates"))
+}
+
+// LoadTemplates parses the templates in box
+func LoadTemplates(box packr.Box) (*Templates, error) {
+	base := template.New("").Funcs(templateFuncs)
+
+	// locale -> partials and mails of the locale
+	partials := map[string][]string{}
+	mails := map[string][]string{}
+
+	files := box.List()
+	sort.Strings(files)
+
+	for _, file := range files {
+		file = path.Clean(strings.Replace(file, "\\", "/", -1))
+
+		if path.Ext(file) != ".html" {
+			continue
+		}
+
+		dir, name := path.Split(file)
+		dir = strings.TrimSuffix(dir, "/")
+
+		switch {
+		case dir == "layouts" || dir == "partials":
+			if err := parse(base, box, file); err != nil {
+				return nil, err
+			}
+		case strings.Contains(dir, "/") || dir == "":
+			return nil, fmt.Errorf("unexpected mail template: %s", file)
+		case strings.HasPrefix(name, "_"):
+			partials[dir] = append(partials[dir], file)
+		default:
+			mails[dir] = append(mails[dir], file)
+		}
+	}
+
+	t := &Templates{mails: map[string]map[string]*template.Template{}}
+
+	for locale, files := range mails {
+		t.mails[locale] = map[string]*template.Template{}
+
+		for _, file := range files {
+			tmpl, err := base.Clone()
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, f := range partials[locale] {
+				if err := parse(tmpl, box, f); err != nil {
+					return nil, err
+				}
+			}
+
+			if err := parse(tmpl, box, file); err != nil {
+				return nil, err
+			}
+
+			for _, required := range []string{"layout", "subject", "content", "footer"} {
+				if tmpl.Lookup(required) == nil {
+					return nil, fmt.Errorf("mail template %s does not define %q", file, required)
+				}
+			}
+
+			t.mails[locale][strings.TrimSuffix(path.Base(file), ".html")] = tmpl
+		}
+	}
+
+	if len(t.mails[DefaultLocale]) == 0 {
+		return nil, fmt.Errorf("no mail templates for the default locale %s", DefaultLocale)
+	}
+
+	return t, nil
+}
+
+func parse(tmpl *template.Template, box packr.Box, file string) error {
+	text, err := box.FindString(file)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmpl.Parse(text); err != nil {
+		return fmt.Errorf("failed to parse mail template %s: %v", file, err)
+	}
+
+	return nil
+}
+
+// NewMail renders the mail of name to the recipient in the language requested in ctx
+func (t *Templates) NewMail(ctx context.Context, to string, name string, data interface{}) (*Mail, error) {
+	m, err := t.Render(translations.Language(ctx), name, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m.Recipient = to
+
+	return m, nil
+}
+
+// Render renders the subject and the bodies of the mail of name in the language of tag.
+// The templates of DefaultLocale are used if the mail is not translated to the language.
+func (t *Templates) Render(tag language.Tag, name string, data interface{}) (*Mail, error) {
+	return t.render(tag, name, data, "")
+}
+
+// RenderUnsubscribable renders the mail like Render with the link to unsubscribeURL in the footer
+// and the List-Unsubscribe headers, so that mail clients unsubscribe by a POST request to
+// unsubscribeURL without opening it (RFC 8058).
+func (t *Templates) RenderUnsubscribable(tag language.Tag, name string, data interface{}, unsubscribeURL string) (*Mail, error) {
+	m, err := t.render(tag, name, data, unsubscribeURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m.SetHeader("List-Unsubscribe", "<"+unsubscribeURL+">")
+	m.SetHeader("List-Unsubscribe-Post", "List-Unsubscribe=One-Click")
+
+	return m, nil
+}
+
+func (t *Templates) render(tag language.Tag, name string, data interface{}, unsubscribeURL string) (*Mail, error) {
+	base, _ := tag.Base()
+	locale := base.String()
+
+	tmpl, ok := t.mails[locale][name]
+
+	if !ok {
+		locale = DefaultLocale
+		tmpl, ok = t.mails[locale][name]
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("mail template %s is not found", name)
+	}
+
+	var subject, body bytes.Buffer
+
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, err
+	}
+
+	if err := tmpl.ExecuteTemplate(&body, "layout", layoutData{Lang: locale, Data: data, UnsubscribeURL: unsubscribeURL}); err != nil {
+		return nil, err
+	}
+
+	htmlBody, err := InlineCSS(body.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	textBody, err := HTMLToText(htmlBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mail{
+		// the subject is escaped as HTML by html/template
+		Subject:  html.UnescapeString(strings.TrimSpace(subject.String())),
+		HTMLBody: htmlBody,
+		TextBody: textBody,
+	}, nil
+}
+
+// Names returns the names of the mails of each locale
+func (t *Templates) Names() map[string][]string {
+	names := map[string][]string{}
+
+	for locale, mails := range t.mails {
+		for name := range mails {
+			names[locale] = append(names[locale], name)
+		}
+
+		sort.Strings(names[locale])
+	}
+
+	return names
+}
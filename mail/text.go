@@ -0,0 +1,113 @@
+package mail
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// blockElements start on a new line in the text
+var blockElements = map[atom.Atom]bool{
+	atom.Address: true, atom.Article: true, atom.Blockquote: true, atom.Div: true,
+	atom.Footer: true, atom.Header: true, atom.Li: true, atom.Ol: true,
+	atom.Section: true, atom.Table: true, atom.Tr: true, atom.Ul: true,
+}
+
+// paragraphElements are separated from the others by a blank line
+var paragraphElements = map[atom.Atom]bool{
+	atom.P: true, atom.H1: true, atom.H2: true, atom.H3: true,
+	atom.H4: true, atom.H5: true, atom.H6: true, atom.Pre: true,
+}
+
+// hiddenElements are not shown in the text
+var hiddenElements = map[atom.Atom]bool{
+	atom.Head: true, atom.Script: true, atom.Style: true, atom.Title: true,
+}
+
+var (
+	spaces     = regexp.MustCompile(`[ \t\r\n\f]+`)
+	blankLines = regexp.MustCompile(`\n{3,}`)
+)
+
+// HTMLToText generates the plain text body from the HTML body.
+// Links are written with the URL in parentheses after the text.
+func HTMLToText(doc string) (string, error) {
+	root, err := html.Parse(strings.NewReader(doc))
+
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	writeText(&b, root)
+
+	lines := strings.Split(b.String(), "\n")
+
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	text := blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+
+	return strings.TrimSpace(text) + "\n", nil
+}
+
+func writeText(b *strings.Builder, n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		b.WriteString(spaces.ReplaceAllString(n.Data, " "))
+		return
+	case html.ElementNode:
+		if hiddenElements[n.DataAtom] {
+			return
+		}
+	}
+
+	switch {
+	case n.DataAtom == atom.Br:
+		b.WriteString("\n")
+		return
+	case n.DataAtom == atom.Hr:
+		b.WriteString("\n----------\n")
+		return
+	case n.DataAtom == atom.Li:
+		b.WriteString("\n- ")
+	case paragraphElements[n.DataAtom]:
+		b.WriteString("\n\n")
+	case blockElements[n.DataAtom]:
+		b.WriteString("\n")
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		writeText(b, c)
+	}
+
+	switch {
+	case n.DataAtom == atom.A:
+		href, _ := attr(n, "href")
+
+		if href != "" && href != textContent(n) && !strings.HasPrefix(href, "#") {
+			b.WriteString(" (" + href + ")")
+		}
+	case paragraphElements[n.DataAtom]:
+		b.WriteString("\n\n")
+	case blockElements[n.DataAtom]:
+		b.WriteString("\n")
+	case n.DataAtom == atom.Td || n.DataAtom == atom.Th:
+		b.WriteString(" ")
+	}
+}
+
+func textContent(n *html.Node) string {
+	var b strings.Builder
+
+	walk(n, func(c *html.Node) {
+		if c.Type == html.TextNode {
+			b.WriteString(c.Data)
+		}
+	})
+
+	return strings.TrimSpace(spaces.ReplaceAllString(b.String(), " "))
+}
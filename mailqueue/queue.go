@@ -0,0 +1,353 @@
+// Package mailqueue persists outbound mails in the mail_messages table and
+// delivers them by background workers, so that mutations do not wait for
+// or fail with the mail provider.
+package mailqueue
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/shufo/go-graphql-boilerplate/database"
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// Status of the queued mails
+const (
+	StatusPending = "pending"
+	StatusSending = "sending"
+	StatusSent    = "sent"
+	StatusFailed  = "failed"
+)
+
+// errDuplicateEntry is the MySQL error number of unique index violation
+const errDuplicateEntry = 1062
+
+// ErrNotFailed is returned when retrying the mail which has not failed
+var ErrNotFailed = errors.New("mail message has not failed")
+
+// ErrRedacted is returned when retrying the sensitive mail whose bodies are cleared
+var ErrRedacted = errors.New("mail message is redacted")
+
+// Queue is the Mailer which queues mails to be delivered by transport later
+type Queue struct {
+	db        *sql.DB
+	transport mail.Mailer
+	clock     clock.Clock
+	logger    logrus.FieldLogger
+	config    configs.MailQueue
+}
+
+// New returns the queue in db delivering mails with transport
+func New(db *sql.DB, transport mail.Mailer, clk clock.Clock, logger logrus.FieldLogger, config configs.MailQueue) *Queue {
+	return &Queue{
+		db:        db,
+		transport: transport,
+		clock:     clk,
+		logger:    logger,
+		config:    config,
+	}
+}
+
+// Send queues the mail. The mail is queued in the transaction running in ctx if any,
+// so it is delivered only if the transaction is committed.
+// Mails with the IdempotencyKey already queued are ignored.
+// The bodies of sensitive mails are cleared once they are sent or failed.
+func (q *Queue) Send(ctx context.Context, m *mail.Mail) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	exec := database.Executor(ctx, q.db)
+
+	msg := &models.MailMessage{
+		Recipient:     m.Recipient,
+		Subject:       m.Subject,
+		HTMLBody:      m.HTMLBody,
+		TextBody:      m.TextBody,
+		Status:        StatusPending,
+		NextAttemptAt: q.now(),
+		Sensitive:     m.Sensitive,
+	}
+
+	if len(m.Headers) > 0 {
+		headers, err := json.Marshal(m.Headers)
+
+		if err != nil {
+			return err
+		}
+
+		msg.Headers = null.StringFrom(string(headers))
+	}
+
+	if m.IdempotencyKey != "" {
+		msg.IdempotencyKey = null.StringFrom(m.IdempotencyKey)
+
+		exists, err := models.MailMessages(models.MailMessageWhere.IdempotencyKey.EQ(msg.IdempotencyKey)).Exists(ctx, exec)
+
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return nil
+		}
+	}
+
+	err := msg.Insert(ctx, exec, boil.Infer())
+
+	// the same key may be queued concurrently
+	if e, ok := errors.Cause(err).(*mysql.MySQLError); ok && e.Number == errDuplicateEntry && m.IdempotencyKey != "" {
+		return nil
+	}
+
+	return err
+}
+
+// Run delivers due mails by the workers until ctx is canceled.
+// It returns after the deliveries in progress are finished.
+func (q *Queue) Run(ctx context.Context) {
+	jobs := make(chan *models.MailMessage)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < q.config.Workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for msg := range jobs {
+				// the mails dispatched while canceling are left to the next run
+				if ctx.Err() == nil {
+					q.deliver(ctx, msg)
+				}
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(q.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		msgs, err := q.due(ctx, q.config.Workers*10)
+
+		if err != nil && ctx.Err() == nil {
+			q.logger.WithError(err).Error("failed to look up queued mails")
+		}
+
+	dispatch:
+		for _, msg := range msgs {
+			select {
+			case jobs <- msg:
+			case <-ctx.Done():
+				break dispatch
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			close(jobs)
+			wg.Wait()
+			return
+		}
+	}
+}
+
+// ProcessDue delivers the mails due now one by one and returns the number of the delivered mails.
+// It is used to deliver mails without running workers like in tests.
+func (q *Queue) ProcessDue(ctx context.Context) (int, error) {
+	msgs, err := q.due(ctx, 100)
+
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+
+	for _, msg := range msgs {
+		if q.deliver(ctx, msg) {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// Retry queues the failed mail again with the attempts reset.
+// Sensitive mails can't be retried as their bodies are cleared.
+func (q *Queue) Retry(ctx context.Context, id int) (*models.MailMessage, error) {
+	exec := database.Executor(ctx, q.db)
+
+	msg, err := models.FindMailMessage(ctx, exec, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Status != StatusFailed {
+		return nil, ErrNotFailed
+	}
+
+	if msg.Sensitive {
+		return nil, ErrRedacted
+	}
+
+	msg.Status = StatusPending
+	msg.Attempts = 0
+	msg.NextAttemptAt = q.now()
+
+	if _, err := msg.Update(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// due returns the mails to be delivered now. Mails left in sending after
+// the lock timeout are included as well.
+func (q *Queue) due(ctx context.Context, limit int) (models.MailMessageSlice, error) {
+	return models.MailMessages(
+		qm.WhereIn("status in ?", StatusPending, StatusSending),
+		models.MailMessageWhere.NextAttemptAt.LTE(q.clock.Now()),
+		qm.OrderBy("next_attempt_at asc, id asc"),
+		qm.Limit(limit),
+	).All(ctx, q.db)
+}
+
+// deliver sends the mail if no other worker has claimed it and reports whether it was sent
+func (q *Queue) deliver(ctx context.Context, msg *models.MailMessage) bool {
+	claimed, err := q.claim(ctx, msg)
+
+	if err != nil {
+		q.logger.WithError(err).WithField("mail_message_id", msg.ID).Error("failed to claim queued mail")
+		return false
+	}
+
+	if !claimed {
+		return false
+	}
+
+	m := &mail.Mail{
+		Recipient: msg.Recipient,
+		Subject:   msg.Subject,
+		HTMLBody:  msg.HTMLBody,
+		TextBody:  msg.TextBody,
+	}
+
+	var sendErr error
+
+	if msg.Headers.Valid {
+		sendErr = json.Unmarshal([]byte(msg.Headers.String), &m.Headers)
+	}
+
+	if sendErr == nil {
+		sendErr = q.send(m)
+	}
+
+	now := q.clock.Now()
+	cols := models.M{"updated_at": now}
+
+	switch {
+	case sendErr == nil:
+		cols["status"] = StatusSent
+		cols["sent_at"] = now
+	case errors.Cause(sendErr) == mail.ErrSuppressed, msg.Attempts >= q.config.MaxAttempts:
+		// suppressed recipients never receive the mail however many times it is retried
+		cols["status"] = StatusFailed
+		cols["last_error"] = sendErr.Error()
+	default:
+		cols["status"] = StatusPending
+		cols["last_error"] = sendErr.Error()
+		cols["next_attempt_at"] = now.Add(q.backoff(msg.Attempts))
+	}
+
+	// secrets in the bodies are not kept once the mail is not delivered any more
+	if msg.Sensitive && cols["status"] != StatusPending {
+		cols["html_body"] = ""
+		cols["text_body"] = ""
+	}
+
+	if sendErr != nil {
+		q.logger.WithError(sendErr).WithFields(logrus.Fields{
+			"mail_message_id": msg.ID,
+			"attempts":        msg.Attempts,
+			"status":          cols["status"],
+		}).Warn("failed to deliver queued mail")
+	}
+
+	// the update is not canceled with ctx so that the result of the delivery is kept on shutdown
+	if _, err := models.MailMessages(models.MailMessageWhere.ID.EQ(msg.ID)).UpdateAll(context.Background(), q.db, cols); err != nil {
+		q.logger.WithError(err).WithField("mail_message_id", msg.ID).Error("failed to update queued mail")
+	}
+
+	return sendErr == nil
+}
+
+// send sends the mail by the transport within SendTimeout, which is less than LockTimeout
+// so that the mail is not claimed again while it is being sent. The attempt is not canceled
+// on shutdown, as the mail may be sent again if it is aborted after the provider accepted it.
+func (q *Queue) send(m *mail.Mail) error {
+	ctx, cancel := context.WithTimeout(context.Background(), q.config.SendTimeout)
+	defer cancel()
+
+	return q.transport.Send(ctx, m)
+}
+
+// claim marks the mail as sending and counts the attempt. The update is
+// conditioned on the attempts read, so only one worker claims each attempt.
+func (q *Queue) claim(ctx context.Context, msg *models.MailMessage) (bool, error) {
+	now := q.clock.Now()
+
+	n, err := models.MailMessages(
+		models.MailMessageWhere.ID.EQ(msg.ID),
+		models.MailMessageWhere.Attempts.EQ(msg.Attempts),
+		qm.WhereIn("status in ?", StatusPending, StatusSending),
+	).UpdateAll(ctx, q.db, models.M{
+		"status":          StatusSending,
+		"attempts":        msg.Attempts + 1,
+		"next_attempt_at": now.Add(q.config.LockTimeout),
+		"updated_at":      now,
+	})
+
+	if err != nil || n == 0 {
+		return false, err
+	}
+
+	msg.Attempts++
+
+	return true, nil
+}
+
+// now returns the current time truncated to seconds, since DATETIME columns
+// round fractional seconds and the mail would not be due until the next second
+func (q *Queue) now() time.Time {
+	return q.clock.Now().Truncate(time.Second)
+}
+
+// backoff returns the delay before the next attempt, which is doubled for each attempt
+func (q *Queue) backoff(attempts int) time.Duration {
+	d := q.config.Backoff
+
+	for i := 1; i < attempts && d < q.config.MaxBackoff; i++ {
+		d *= 2
+	}
+
+	if d > q.config.MaxBackoff {
+		d = q.config.MaxBackoff
+	}
+
+	return d
+}
@@ -0,0 +1,238 @@
+package mailqueue_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/shufo/go-graphql-boilerplate/mailqueue"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// transport counts the mails sent and fails with err if it is set
+type transport struct {
+	mu   sync.Mutex
+	sent int
+	err  error
+}
+
+func (t *transport) Send(ctx context.Context, m *mail.Mail) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.err != nil {
+		return t.err
+	}
+
+	t.sent++
+
+	return nil
+}
+
+type QueueSuite struct {
+	suite.Suite
+	db       *sql.DB
+	fixtures *testfixtures.Context
+	clock    *clock.Mock
+	config   configs.MailQueue
+}
+
+func (suite *QueueSuite) SetupSuite() {
+	suite.db = testutils.PrepareDB()
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *QueueSuite) TearDownSuite() {
+	suite.db.Close()
+}
+
+func (suite *QueueSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+
+	// DATETIME columns keep seconds
+	suite.clock = clock.NewMock(time.Now().Truncate(time.Second))
+	suite.config = configs.MailQueue{
+		Workers:      1,
+		PollInterval: time.Second,
+		MaxAttempts:  6,
+		Backoff:      time.Minute,
+		MaxBackoff:   10 * time.Minute,
+		LockTimeout:  5 * time.Minute,
+		SendTimeout:  time.Minute,
+	}
+}
+
+// queue returns the queue delivering with t
+func (suite *QueueSuite) queue(t mail.Mailer) *mailqueue.Queue {
+	return mailqueue.New(suite.db, t, suite.clock, logrus.New(), suite.config)
+}
+
+// send queues a mail and returns it
+func (suite *QueueSuite) send(q *mailqueue.Queue) *models.MailMessage {
+	err := q.Send(context.Background(), &mail.Mail{
+		Recipient: "success@simulator.amazonses.com",
+		Subject:   "subject",
+		HTMLBody:  "<p>body</p>",
+		TextBody:  "body",
+	})
+	suite.Require().NoError(err)
+
+	msg, err := models.MailMessages(models.MailMessageWhere.Status.EQ(mailqueue.StatusPending)).One(context.Background(), suite.db)
+	suite.Require().NoError(err)
+
+	return msg
+}
+
+// The delay before the next attempt is doubled for each attempt up to MaxBackoff,
+// and the mail fails after MaxAttempts
+func (suite *QueueSuite) TestBackoff() {
+	ctx := context.Background()
+	q := suite.queue(&transport{err: errors.New("connection refused")})
+	msg := suite.send(q)
+
+	for _, c := range []struct {
+		attempts int
+		status   string
+		delay    time.Duration
+	}{
+		{attempts: 1, status: mailqueue.StatusPending, delay: time.Minute},
+		{attempts: 2, status: mailqueue.StatusPending, delay: 2 * time.Minute},
+		{attempts: 3, status: mailqueue.StatusPending, delay: 4 * time.Minute},
+		{attempts: 4, status: mailqueue.StatusPending, delay: 8 * time.Minute},
+		{attempts: 5, status: mailqueue.StatusPending, delay: 10 * time.Minute},
+		{attempts: 6, status: mailqueue.StatusFailed},
+	} {
+		sent, err := q.ProcessDue(ctx)
+		suite.Require().NoError(err)
+		suite.Equal(0, sent)
+
+		suite.Require().NoError(msg.Reload(ctx, suite.db))
+		suite.Equal(c.attempts, msg.Attempts, "attempt %d", c.attempts)
+		suite.Equal(c.status, msg.Status, "attempt %d", c.attempts)
+		suite.Equal("connection refused", msg.LastError.String, "attempt %d", c.attempts)
+
+		if c.status != mailqueue.StatusPending {
+			continue
+		}
+
+		suite.Equal(c.delay, msg.NextAttemptAt.Sub(suite.clock.Now()), "attempt %d", c.attempts)
+
+		// the mail is not retried before the delay
+		suite.clock.Add(c.delay - time.Second)
+		sent, err = q.ProcessDue(ctx)
+		suite.Require().NoError(err)
+		suite.Equal(0, sent)
+		suite.Require().NoError(msg.Reload(ctx, suite.db))
+		suite.Equal(c.attempts, msg.Attempts, "attempt %d", c.attempts)
+
+		suite.clock.Add(time.Second)
+	}
+
+	// failed mails are not retried
+	suite.clock.Add(time.Hour)
+	_, err := q.ProcessDue(ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(msg.Reload(ctx, suite.db))
+	suite.Equal(6, msg.Attempts)
+}
+
+// Only the mails due now are claimed and the claimed mails are not claimed again until the lock timeout
+func (suite *QueueSuite) TestClaim() {
+	ctx := context.Background()
+
+	for _, c := range []struct {
+		name     string
+		status   string
+		attempts int
+		// due is the time until the next attempt
+		due     time.Duration
+		claimed bool
+	}{
+		{name: "pending", status: mailqueue.StatusPending, claimed: true},
+		{name: "pending later", status: mailqueue.StatusPending, due: time.Second},
+		{name: "retried", status: mailqueue.StatusPending, attempts: 2, due: -time.Minute, claimed: true},
+		{name: "sending", status: mailqueue.StatusSending, attempts: 1, due: time.Minute},
+		{name: "sending after lock timeout", status: mailqueue.StatusSending, attempts: 1, due: -time.Second, claimed: true},
+		{name: "sent", status: mailqueue.StatusSent, attempts: 1, due: -time.Minute},
+		{name: "failed", status: mailqueue.StatusFailed, attempts: 6, due: -time.Minute},
+	} {
+		t := &transport{}
+		q := suite.queue(t)
+
+		msg := &models.MailMessage{
+			Recipient:     "success@simulator.amazonses.com",
+			Subject:       c.name,
+			TextBody:      "body",
+			Status:        c.status,
+			Attempts:      c.attempts,
+			NextAttemptAt: suite.clock.Now().Add(c.due),
+		}
+		suite.Require().NoError(msg.Insert(ctx, suite.db, boil.Infer()))
+
+		sent, err := q.ProcessDue(ctx)
+		suite.Require().NoError(err, c.name)
+		suite.Require().NoError(msg.Reload(ctx, suite.db))
+
+		if c.claimed {
+			suite.Equal(1, sent, c.name)
+			suite.Equal(1, t.sent, c.name)
+			suite.Equal(c.attempts+1, msg.Attempts, c.name)
+			suite.Equal(mailqueue.StatusSent, msg.Status, c.name)
+		} else {
+			suite.Equal(0, t.sent, c.name)
+			suite.Equal(c.attempts, msg.Attempts, c.name)
+			suite.Equal(c.status, msg.Status, c.name)
+		}
+
+		_, err = msg.Delete(ctx, suite.db)
+		suite.Require().NoError(err)
+	}
+}
+
+// Each attempt is claimed by only one of the workers looking up the same mail
+func (suite *QueueSuite) TestConcurrentClaims() {
+	t := &transport{}
+	q := suite.queue(t)
+	msg := suite.send(q)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			q.ProcessDue(context.Background())
+		}()
+	}
+
+	wg.Wait()
+
+	suite.Equal(1, t.sent)
+	suite.Require().NoError(msg.Reload(context.Background(), suite.db))
+	suite.Equal(1, msg.Attempts)
+	suite.Equal(mailqueue.StatusSent, msg.Status)
+}
+
+func TestQueueSuite(t *testing.T) {
+	suite.Run(t, new(QueueSuite))
+}
@@ -1,30 +1,118 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
 
+	_ "github.com/jinzhu/gorm/dialects/mysql"
+	"github.com/shufo/go-graphql-boilerplate/configs"
 	"github.com/shufo/go-graphql-boilerplate/server"
 	"github.com/shufo/go-graphql-boilerplate/utils"
-	_ "github.com/jinzhu/gorm/dialects/mysql"
 )
 
-const defaultPort = "8080"
+const usage = `usage: %s [-config path] [command]
+
+Commands:
+  serve         start the server (default)
+  config print  print the config with the secrets redacted
+
+The config is read from the YAML or TOML file and overridden by the environment variables.
+
+Flags:
+`
+
+// shutdownTimeout is the time given to the requests in progress on shutdown
+const shutdownTimeout = 30 * time.Second
 
 func main() {
-	port := os.Getenv("PORT")
+	path := flag.String("config", os.Getenv("CONFIG_FILE"), "path to the config file in YAML or TOML")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage, os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	config, err := configs.Load(*path)
+
+	switch command := flag.Args(); {
+	case len(command) == 0 || command[0] == "serve":
+		if err != nil {
+			log.Fatal(err)
+		}
 
-	if port == "" {
-		port = defaultPort
+		serve(config)
+	case len(command) == 2 && command[0] == "config" && command[1] == "print":
+		if err := configs.Print(os.Stdout, config); err != nil {
+			log.Fatal(err)
+		}
+
+		// print the problems after the config to tell which settings to fix
+		if err != nil {
+			log.Fatal(err)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
 	}
+}
 
-	c := server.Config{Logging: true}
+func serve(config configs.Config) {
+	c := server.Config{App: config, Logging: true}
 	s := server.NewServer(c)
 	db := s.OpenDBConnection()
 	utils.MigrateDB(db)
 	r := s.Router(db)
 
+	// the mail queue and the server are stopped on SIGTERM or SIGINT
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		s.RunMailQueue(ctx)
+	}()
+
+	port := strconv.Itoa(config.Port)
+	srv := &http.Server{Addr: ":" + port, Handler: r}
+
+	go func() {
+		<-ctx.Done()
+
+		// the requests in progress are given time to finish
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Printf("failed to shut down the server: %v", err)
+		}
+	}()
+
 	log.Printf("connect to http://localhost:%s/ for GraphQL playground", port)
-	log.Fatal(http.ListenAndServe(":"+port, r))
+
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+
+	// the mails being sent are delivered before exiting
+	wg.Wait()
 }
@@ -0,0 +1,38 @@
+-- +migrate Up
+
+-- -----------------------------------------------------
+-- Role hierarchy is defined by `rank`. A user who holds
+-- a role with a higher rank satisfies every role below it.
+-- -----------------------------------------------------
+ALTER TABLE `roles`
+  MODIFY COLUMN `type` ENUM('ORGANIZATION_MEMBER', 'ORGANIZATION_ADMIN', 'USER', 'SUPER_ADMIN', 'RESOURCE_OWNER') NOT NULL;
+
+ALTER TABLE `roles`
+  ADD COLUMN `rank` INT NOT NULL DEFAULT 0 COMMENT 'Position of the role in the hierarchy. Higher rank includes lower ones.' AFTER `type`;
+
+INSERT INTO roles
+(id, `type`, created_at, updated_at)
+VALUES(5, 'RESOURCE_OWNER', '2019-04-01 00:00:00', '2019-04-01 00:00:00');
+
+UPDATE roles SET `rank` = 10 WHERE `type` = 'USER';
+UPDATE roles SET `rank` = 20 WHERE `type` = 'RESOURCE_OWNER';
+UPDATE roles SET `rank` = 30 WHERE `type` = 'ORGANIZATION_MEMBER';
+UPDATE roles SET `rank` = 40 WHERE `type` = 'ORGANIZATION_ADMIN';
+UPDATE roles SET `rank` = 50 WHERE `type` = 'SUPER_ADMIN';
+
+-- -----------------------------------------------------
+-- Roles granted within an organization. NULL means the
+-- role is granted globally.
+-- -----------------------------------------------------
+ALTER TABLE `user_roles`
+  ADD COLUMN `organization_id` INT NULL COMMENT 'The organization the role is granted in. NULL for global roles.' AFTER `role_id`;
+
+CREATE INDEX `idx_user_roles_user_id_organization_id` ON `user_roles` (`user_id` ASC, `organization_id` ASC);
+
+-- +migrate Down
+DROP INDEX `idx_user_roles_user_id_organization_id` ON `user_roles`;
+ALTER TABLE `user_roles` DROP COLUMN `organization_id`;
+DELETE FROM roles WHERE `type` = 'RESOURCE_OWNER';
+ALTER TABLE `roles` DROP COLUMN `rank`;
+ALTER TABLE `roles`
+  MODIFY COLUMN `type` ENUM('ORGANIZATION_MEMBER', 'ORGANIZATION_ADMIN', 'USER', 'SUPER_ADMIN') NOT NULL;
@@ -0,0 +1,26 @@
+-- +migrate Up
+
+-- -----------------------------------------------------
+-- Table `audit_events`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `audit_events` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `action` VARCHAR(64) NOT NULL COMMENT 'What happened such as login_succeeded, password_reset_requested',
+  `actor_id` INT NULL COMMENT 'The user who did the action. NULL for anonymous user.',
+  `target_type` VARCHAR(64) NULL COMMENT 'The type of the object the action is taken on',
+  `target_id` INT NULL COMMENT 'The id of the object the action is taken on',
+  `ip_address` VARCHAR(45) NULL,
+  `request_id` VARCHAR(64) NULL,
+  `diff` JSON NULL COMMENT 'Changes made by the action',
+  `created_at` DATETIME NOT NULL COMMENT 'Created UTC time',
+  PRIMARY KEY (`id`),
+  INDEX `idx_audit_events_action` (`action` ASC),
+  INDEX `idx_audit_events_actor_id` (`actor_id` ASC),
+  INDEX `idx_audit_events_target` (`target_type` ASC, `target_id` ASC),
+  INDEX `idx_audit_events_created_at` (`created_at` ASC))
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COMMENT = 'Records security relevant events';
+
+-- +migrate Down
+DROP TABLE `audit_events`;
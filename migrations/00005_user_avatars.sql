@@ -0,0 +1,11 @@
+-- +migrate Up
+
+-- -----------------------------------------------------
+-- Avatar images are stored in the blob storage as
+-- avatars/<user id>/<avatar key>/<size>.<ext>
+-- -----------------------------------------------------
+ALTER TABLE `users`
+  ADD COLUMN `avatar_key` VARCHAR(255) NULL COMMENT 'The key of the current avatar images in the blob storage' AFTER `email`;
+
+-- +migrate Down
+ALTER TABLE `users` DROP COLUMN `avatar_key`;
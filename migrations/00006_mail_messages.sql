@@ -0,0 +1,29 @@
+-- +migrate Up
+
+-- -----------------------------------------------------
+-- Table `mail_messages`
+-- Outbound mails are queued here and delivered by workers
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mail_messages` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `idempotency_key` VARCHAR(191) NULL COMMENT 'Mails with the same key are queued only once',
+  `recipient` VARCHAR(255) NOT NULL,
+  `subject` VARCHAR(255) NOT NULL,
+  `html_body` MEDIUMTEXT NOT NULL,
+  `text_body` MEDIUMTEXT NOT NULL,
+  `status` VARCHAR(16) NOT NULL COMMENT 'pending, sending, sent or failed',
+  `attempts` INT NOT NULL DEFAULT 0 COMMENT 'The number of delivery attempts',
+  `last_error` TEXT NULL COMMENT 'The error of the last failed attempt',
+  `next_attempt_at` DATETIME NOT NULL COMMENT 'The message is delivered after this UTC time',
+  `sent_at` DATETIME NULL,
+  `created_at` DATETIME NOT NULL COMMENT 'Created UTC time',
+  `updated_at` DATETIME NOT NULL COMMENT 'Updated UTC time',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_mail_messages_idempotency_key` (`idempotency_key` ASC),
+  INDEX `idx_mail_messages_due` (`status` ASC, `next_attempt_at` ASC))
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COMMENT = 'Outbound mail queue';
+
+-- +migrate Down
+DROP TABLE `mail_messages`;
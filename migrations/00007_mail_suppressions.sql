@@ -0,0 +1,22 @@
+-- +migrate Up
+
+-- -----------------------------------------------------
+-- Table `mail_suppressions`
+-- Mails are not sent to the addresses which bounced or complained
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mail_suppressions` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `email` VARCHAR(191) NOT NULL,
+  `reason` VARCHAR(16) NOT NULL COMMENT 'bounce or complaint',
+  `detail` VARCHAR(255) NULL COMMENT 'The bounce type or the complaint feedback type',
+  `feedback_id` VARCHAR(255) NULL COMMENT 'The ID of the notification given by SES',
+  `created_at` DATETIME NOT NULL COMMENT 'Created UTC time',
+  `updated_at` DATETIME NOT NULL COMMENT 'Updated UTC time',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_mail_suppressions_email` (`email` ASC))
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COMMENT = 'Addresses which mails are not sent to';
+
+-- +migrate Down
+DROP TABLE `mail_suppressions`;
@@ -0,0 +1,30 @@
+-- +migrate Up
+
+-- -----------------------------------------------------
+-- Table `user_notification_preferences`
+-- Only the preferences changed from the defaults are stored
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `user_notification_preferences` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `user_id` INT NOT NULL,
+  `category` VARCHAR(32) NOT NULL COMMENT 'account, product_updates or marketing',
+  `channel` VARCHAR(16) NOT NULL COMMENT 'email or push',
+  `enabled` TINYINT(1) NOT NULL,
+  `created_at` DATETIME NOT NULL COMMENT 'Created UTC time',
+  `updated_at` DATETIME NOT NULL COMMENT 'Updated UTC time',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_user_notification_preferences` (`user_id` ASC, `category` ASC, `channel` ASC),
+  CONSTRAINT `fk_user_notification_preferences_users`
+    FOREIGN KEY (`user_id`)
+    REFERENCES `users` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COMMENT = 'Notification preferences of users';
+
+ALTER TABLE `mail_messages` ADD COLUMN `headers` TEXT NULL COMMENT 'Additional mail headers in JSON';
+
+-- +migrate Down
+ALTER TABLE `mail_messages` DROP COLUMN `headers`;
+DROP TABLE `user_notification_preferences`;
@@ -0,0 +1,12 @@
+-- +migrate Up
+
+-- Only SHA-256 of the tokens is stored so that leaked rows can not be used to reset passwords.
+-- Outstanding raw tokens can not be verified any more and are invalidated.
+ALTER TABLE `password_resets` CHANGE COLUMN `password_reset_token` `token_hash` CHAR(64) NULL COMMENT 'SHA-256 of the reset token in hex';
+ALTER TABLE `password_resets` MODIFY COLUMN `status` ENUM('none', 'sent', 'verified', 'completed', 'invalidated') NULL;
+UPDATE `password_resets` SET `status` = 'invalidated', `token_hash` = NULL WHERE `status` IN ('sent', 'verified');
+
+-- +migrate Down
+UPDATE `password_resets` SET `status` = 'none' WHERE `status` IN ('completed', 'invalidated');
+ALTER TABLE `password_resets` MODIFY COLUMN `status` ENUM('none', 'sent', 'verified') NULL;
+ALTER TABLE `password_resets` CHANGE COLUMN `token_hash` `password_reset_token` VARCHAR(45) NULL;
@@ -0,0 +1,7 @@
+-- +migrate Up
+
+-- Bodies of sensitive mails like password reset links are cleared once they are sent or failed
+ALTER TABLE `mail_messages` ADD COLUMN `sensitive` TINYINT(1) NOT NULL DEFAULT 0 COMMENT 'The bodies are cleared once the mail is sent or failed';
+
+-- +migrate Down
+ALTER TABLE `mail_messages` DROP COLUMN `sensitive`;
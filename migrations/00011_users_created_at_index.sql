@@ -0,0 +1,7 @@
+-- +migrate Up
+
+-- Users are paginated by keyset on (created_at, id) when they are sorted by created_at
+ALTER TABLE `users` ADD INDEX `idx_created_at_id` (`created_at` ASC, `id` ASC);
+
+-- +migrate Down
+ALTER TABLE `users` DROP INDEX `idx_created_at_id`;
@@ -4,19 +4,27 @@
 package models
 
 var TableNames = struct {
-	AuthTokens              string
-	AuthenticationProviders string
-	PasswordResets          string
-	Profiles                string
-	Roles                   string
-	UserRoles               string
-	Users                   string
+	AuditEvents                 string
+	AuthTokens                  string
+	AuthenticationProviders     string
+	MailMessages                string
+	MailSuppressions            string
+	PasswordResets              string
+	Profiles                    string
+	Roles                       string
+	UserNotificationPreferences string
+	UserRoles                   string
+	Users                       string
 }{
-	AuthTokens:              "auth_tokens",
-	AuthenticationProviders: "authentication_providers",
-	PasswordResets:          "password_resets",
-	Profiles:                "profiles",
-	Roles:                   "roles",
-	UserRoles:               "user_roles",
-	Users:                   "users",
+	AuditEvents:                 "audit_events",
+	AuthTokens:                  "auth_tokens",
+	AuthenticationProviders:     "authentication_providers",
+	MailMessages:                "mail_messages",
+	MailSuppressions:            "mail_suppressions",
+	PasswordResets:              "password_resets",
+	Profiles:                    "profiles",
+	Roles:                       "roles",
+	UserNotificationPreferences: "user_notification_preferences",
+	UserRoles:                   "user_roles",
+	Users:                       "users",
 }
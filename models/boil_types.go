@@ -61,9 +61,11 @@ const (
 
 // Enum values for password_resets.status
 const (
-	PasswordResetsStatusNone     = "none"
-	PasswordResetsStatusSent     = "sent"
-	PasswordResetsStatusVerified = "verified"
+	PasswordResetsStatusNone        = "none"
+	PasswordResetsStatusSent        = "sent"
+	PasswordResetsStatusVerified    = "verified"
+	PasswordResetsStatusCompleted   = "completed"
+	PasswordResetsStatusInvalidated = "invalidated"
 )
 
 // Enum values for roles.type
@@ -72,4 +74,5 @@ const (
 	RolesTypeORGANIZATION_ADMIN  = "ORGANIZATION_ADMIN"
 	RolesTypeUSER                = "USER"
 	RolesTypeSUPER_ADMIN         = "SUPER_ADMIN"
+	RolesTypeRESOURCE_OWNER      = "RESOURCE_OWNER"
 )
@@ -6,8 +6,37 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
+// An object with a global ID.
+// The ID is opaque and unique across types. Use it to refetch the object with `node`.
+type Node interface {
+	IsNode()
+}
+
+type AuditEventConnection struct {
+	Edges    []AuditEventEdge `json:"edges"`
+	PageInfo PageInfo         `json:"pageInfo"`
+}
+
+type AuditEventEdge struct {
+	Cursor string     `json:"cursor"`
+	Node   AuditEvent `json:"node"`
+}
+
+type AuditEventFilter struct {
+	// Filter for audit events
+	Action     *string `json:"action"`
+	ActorID    *int    `json:"actorId"`
+	TargetType *string `json:"targetType"`
+	TargetID   *int    `json:"targetId"`
+	// Events created at or after the time
+	From *time.Time `json:"from"`
+	// Events created before the time
+	To *time.Time `json:"to"`
+}
+
 type AuthUserInput struct {
 	// Input for user login (email)
 	Email    string `json:"email"`
@@ -29,11 +58,92 @@ type CreateUserInput struct {
 	PhoneNumber string `json:"phoneNumber"`
 }
 
+type MailMessageConnection struct {
+	Edges    []MailMessageEdge `json:"edges"`
+	PageInfo PageInfo          `json:"pageInfo"`
+}
+
+type MailMessageEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   MailMessage `json:"node"`
+}
+
+type NotificationPreferenceInput struct {
+	Category NotificationCategory `json:"category"`
+	Channel  NotificationChannel  `json:"channel"`
+	Enabled  bool                 `json:"enabled"`
+}
+
+// Information about pagination in a connection
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+// The event when the password of the user is changed
+type PasswordChangedEvent struct {
+	UserID    int       `json:"userId"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
 type RequestPasswordResetInput struct {
 	// Input for request password reset
 	Email string `json:"email"`
 }
 
+// The event when a session of the user is revoked
+type SessionRevokedEvent struct {
+	UserID    int       `json:"userId"`
+	RevokedAt time.Time `json:"revokedAt"`
+}
+
+type UpdateNotificationPreferencesInput struct {
+	// Input for notification preferences update. Omitted preferences are not changed.
+	Preferences []NotificationPreferenceInput `json:"preferences"`
+}
+
+type UpdateProfileInput struct {
+	// Input for profile update. Omitted fields are not changed.
+	FirstName *string `json:"firstName"`
+	LastName  *string `json:"lastName"`
+	FullName  *string `json:"fullName"`
+	// Phone number in E.164 format like +819012345678
+	PhoneNumber *string `json:"phoneNumber"`
+	// ISO 3166-1 alpha-2 country code like JP
+	Country *string `json:"country"`
+}
+
+type UserConnection struct {
+	Edges    []UserEdge `json:"edges"`
+	PageInfo PageInfo   `json:"pageInfo"`
+	// The number of users matched with the filter
+	TotalCount int `json:"totalCount"`
+}
+
+type UserEdge struct {
+	Cursor string `json:"cursor"`
+	Node   User   `json:"node"`
+}
+
+type UserFilter struct {
+	// Filter for users
+	Role *RoleType `json:"role"`
+	// Users whose username contains the string
+	Username *string `json:"username"`
+	// Users created at or after the time
+	CreatedFrom *time.Time `json:"createdFrom"`
+	// Users created before the time
+	CreatedTo *time.Time `json:"createdTo"`
+}
+
+type UserOrder struct {
+	// Order of users
+	Field     UserOrderField `json:"field"`
+	Direction OrderDirection `json:"direction"`
+}
+
 type ValidatePasswordResetInput struct {
 	// Input for password reset token validation
 	Token string `json:"token"`
@@ -41,10 +151,228 @@ type ValidatePasswordResetInput struct {
 
 // The type return on user authenticated
 type AuthenticatedUser struct {
-	// The user id
-	ID int `json:"id"`
-	// JWT string for authentication
-	Token string `json:"token"`
+	// The user id. null if the user is not signed in by createUser in privacy mode
+	ID *int `json:"id"`
+	// JWT string for authentication. null if the user is not signed in by createUser in privacy mode
+	Token *string `json:"token"`
+}
+
+// SizeOfAvatarImages.SmallIs64px,MediumIs256pxAndLargeIs512pxSquare.
+type AvatarSize string
+
+const (
+	AvatarSizeSmall  AvatarSize = "SMALL"
+	AvatarSizeMedium AvatarSize = "MEDIUM"
+	AvatarSizeLarge  AvatarSize = "LARGE"
+)
+
+var AllAvatarSize = []AvatarSize{
+	AvatarSizeSmall,
+	AvatarSizeMedium,
+	AvatarSizeLarge,
+}
+
+func (e AvatarSize) IsValid() bool {
+	switch e {
+	case AvatarSizeSmall, AvatarSizeMedium, AvatarSizeLarge:
+		return true
+	}
+	return false
+}
+
+func (e AvatarSize) String() string {
+	return string(e)
+}
+
+func (e *AvatarSize) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AvatarSize(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AvatarSize", str)
+	}
+	return nil
+}
+
+func (e AvatarSize) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// DeliveryStatusOfQueuedMails.MailsAreFailedAfterTheMaximumAttempts.
+type MailMessageStatus string
+
+const (
+	MailMessageStatusPending MailMessageStatus = "PENDING"
+	MailMessageStatusSending MailMessageStatus = "SENDING"
+	MailMessageStatusSent    MailMessageStatus = "SENT"
+	MailMessageStatusFailed  MailMessageStatus = "FAILED"
+)
+
+var AllMailMessageStatus = []MailMessageStatus{
+	MailMessageStatusPending,
+	MailMessageStatusSending,
+	MailMessageStatusSent,
+	MailMessageStatusFailed,
+}
+
+func (e MailMessageStatus) IsValid() bool {
+	switch e {
+	case MailMessageStatusPending, MailMessageStatusSending, MailMessageStatusSent, MailMessageStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e MailMessageStatus) String() string {
+	return string(e)
+}
+
+func (e *MailMessageStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MailMessageStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MailMessageStatus", str)
+	}
+	return nil
+}
+
+func (e MailMessageStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// KindOfNotifications.AccountIsTransactionalLikePasswordResetsAndCanNotBeDisabled.MarketingIsDisabledUnlessTheUserOptsIn.
+type NotificationCategory string
+
+const (
+	NotificationCategoryAccount        NotificationCategory = "ACCOUNT"
+	NotificationCategoryProductUpdates NotificationCategory = "PRODUCT_UPDATES"
+	NotificationCategoryMarketing      NotificationCategory = "MARKETING"
+)
+
+var AllNotificationCategory = []NotificationCategory{
+	NotificationCategoryAccount,
+	NotificationCategoryProductUpdates,
+	NotificationCategoryMarketing,
+}
+
+func (e NotificationCategory) IsValid() bool {
+	switch e {
+	case NotificationCategoryAccount, NotificationCategoryProductUpdates, NotificationCategoryMarketing:
+		return true
+	}
+	return false
+}
+
+func (e NotificationCategory) String() string {
+	return string(e)
+}
+
+func (e *NotificationCategory) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationCategory(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationCategory", str)
+	}
+	return nil
+}
+
+func (e NotificationCategory) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// TheWayNotificationsAreDelivered
+type NotificationChannel string
+
+const (
+	NotificationChannelEmail NotificationChannel = "EMAIL"
+	NotificationChannelPush  NotificationChannel = "PUSH"
+)
+
+var AllNotificationChannel = []NotificationChannel{
+	NotificationChannelEmail,
+	NotificationChannelPush,
+}
+
+func (e NotificationChannel) IsValid() bool {
+	switch e {
+	case NotificationChannelEmail, NotificationChannelPush:
+		return true
+	}
+	return false
+}
+
+func (e NotificationChannel) String() string {
+	return string(e)
+}
+
+func (e *NotificationChannel) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationChannel(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationChannel", str)
+	}
+	return nil
+}
+
+func (e NotificationChannel) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// TheDirectionOfTheOrder
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "ASC"
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionAsc,
+	OrderDirectionDesc,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
 type RoleType string
@@ -93,3 +421,44 @@ func (e *RoleType) UnmarshalGQL(v interface{}) error {
 func (e RoleType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type UserOrderField string
+
+const (
+	UserOrderFieldID        UserOrderField = "ID"
+	UserOrderFieldCreatedAt UserOrderField = "CREATED_AT"
+)
+
+var AllUserOrderField = []UserOrderField{
+	UserOrderFieldID,
+	UserOrderFieldCreatedAt,
+}
+
+func (e UserOrderField) IsValid() bool {
+	switch e {
+	case UserOrderFieldID, UserOrderFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e UserOrderField) String() string {
+	return string(e)
+}
+
+func (e *UserOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UserOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UserOrderField", str)
+	}
+	return nil
+}
+
+func (e UserOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
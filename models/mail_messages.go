@@ -0,0 +1,1032 @@
+// Code generated by SQLBoiler (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"github.com/volatiletech/sqlboiler/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/strmangle"
+)
+
+// MailMessage is an object representing the database table.
+type MailMessage struct {
+	ID             int         `gqlgen:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	IdempotencyKey null.String `gqlgen:"idempotency_key" boil:"idempotency_key" json:"idempotency_key,omitempty" toml:"idempotency_key" yaml:"idempotency_key,omitempty"`
+	Recipient      string      `gqlgen:"recipient" boil:"recipient" json:"recipient" toml:"recipient" yaml:"recipient"`
+	Subject        string      `gqlgen:"subject" boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
+	HTMLBody       string      `gqlgen:"html_body" boil:"html_body" json:"html_body" toml:"html_body" yaml:"html_body"`
+	TextBody       string      `gqlgen:"text_body" boil:"text_body" json:"text_body" toml:"text_body" yaml:"text_body"`
+	Status         string      `gqlgen:"status" boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts       int         `gqlgen:"attempts" boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	LastError      null.String `gqlgen:"last_error" boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	NextAttemptAt  time.Time   `gqlgen:"next_attempt_at" boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	SentAt         null.Time   `gqlgen:"sent_at" boil:"sent_at" json:"sent_at,omitempty" toml:"sent_at" yaml:"sent_at,omitempty"`
+	CreatedAt      time.Time   `gqlgen:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time   `gqlgen:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Headers        null.String `gqlgen:"headers" boil:"headers" json:"headers,omitempty" toml:"headers" yaml:"headers,omitempty"`
+	Sensitive      bool        `gqlgen:"sensitive" boil:"sensitive" json:"sensitive" toml:"sensitive" yaml:"sensitive"`
+
+	R *mailMessageR `gqlgen:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L mailMessageL  `gqlgen:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MailMessageColumns = struct {
+	ID             string
+	IdempotencyKey string
+	Recipient      string
+	Subject        string
+	HTMLBody       string
+	TextBody       string
+	Status         string
+	Attempts       string
+	LastError      string
+	NextAttemptAt  string
+	SentAt         string
+	CreatedAt      string
+	UpdatedAt      string
+	Headers        string
+	Sensitive      string
+}{
+	ID:             "id",
+	IdempotencyKey: "idempotency_key",
+	Recipient:      "recipient",
+	Subject:        "subject",
+	HTMLBody:       "html_body",
+	TextBody:       "text_body",
+	Status:         "status",
+	Attempts:       "attempts",
+	LastError:      "last_error",
+	NextAttemptAt:  "next_attempt_at",
+	SentAt:         "sent_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+	Headers:        "headers",
+	Sensitive:      "sensitive",
+}
+
+// Generated where
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var MailMessageWhere = struct {
+	ID             whereHelperint
+	IdempotencyKey whereHelpernull_String
+	Recipient      whereHelperstring
+	Subject        whereHelperstring
+	HTMLBody       whereHelperstring
+	TextBody       whereHelperstring
+	Status         whereHelperstring
+	Attempts       whereHelperint
+	LastError      whereHelpernull_String
+	NextAttemptAt  whereHelpertime_Time
+	SentAt         whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+	Headers        whereHelpernull_String
+	Sensitive      whereHelperbool
+}{
+	ID:             whereHelperint{field: `id`},
+	IdempotencyKey: whereHelpernull_String{field: `idempotency_key`},
+	Recipient:      whereHelperstring{field: `recipient`},
+	Subject:        whereHelperstring{field: `subject`},
+	HTMLBody:       whereHelperstring{field: `html_body`},
+	TextBody:       whereHelperstring{field: `text_body`},
+	Status:         whereHelperstring{field: `status`},
+	Attempts:       whereHelperint{field: `attempts`},
+	LastError:      whereHelpernull_String{field: `last_error`},
+	NextAttemptAt:  whereHelpertime_Time{field: `next_attempt_at`},
+	SentAt:         whereHelpernull_Time{field: `sent_at`},
+	CreatedAt:      whereHelpertime_Time{field: `created_at`},
+	UpdatedAt:      whereHelpertime_Time{field: `updated_at`},
+	Headers:        whereHelpernull_String{field: `headers`},
+	Sensitive:      whereHelperbool{field: `sensitive`},
+}
+
+// MailMessageRels is where relationship names are stored.
+var MailMessageRels = struct {
+}{}
+
+// mailMessageR is where relationships are stored.
+type mailMessageR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*mailMessageR) NewStruct() *mailMessageR {
+	return &mailMessageR{}
+}
+
+// mailMessageL is where Load methods for each relationship are stored.
+type mailMessageL struct{}
+
+var (
+	mailMessageColumns               = []string{"id", "idempotency_key", "recipient", "subject", "html_body", "text_body", "status", "attempts", "last_error", "next_attempt_at", "sent_at", "created_at", "updated_at", "headers", "sensitive"}
+	mailMessageColumnsWithoutDefault = []string{"idempotency_key", "recipient", "subject", "html_body", "text_body", "status", "last_error", "next_attempt_at", "sent_at", "created_at", "updated_at", "headers"}
+	mailMessageColumnsWithDefault    = []string{"id", "attempts", "sensitive"}
+	mailMessagePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// MailMessageSlice is an alias for a slice of pointers to MailMessage.
+	// This should generally be used opposed to []MailMessage.
+	MailMessageSlice []*MailMessage
+	// MailMessageHook is the signature for custom MailMessage hook methods
+	MailMessageHook func(context.Context, boil.ContextExecutor, *MailMessage) error
+
+	mailMessageQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	mailMessageType                 = reflect.TypeOf(&MailMessage{})
+	mailMessageMapping              = queries.MakeStructMapping(mailMessageType)
+	mailMessagePrimaryKeyMapping, _ = queries.BindMapping(mailMessageType, mailMessageMapping, mailMessagePrimaryKeyColumns)
+	mailMessageInsertCacheMut       sync.RWMutex
+	mailMessageInsertCache          = make(map[string]insertCache)
+	mailMessageUpdateCacheMut       sync.RWMutex
+	mailMessageUpdateCache          = make(map[string]updateCache)
+	mailMessageUpsertCacheMut       sync.RWMutex
+	mailMessageUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var mailMessageBeforeInsertHooks []MailMessageHook
+var mailMessageBeforeUpdateHooks []MailMessageHook
+var mailMessageBeforeDeleteHooks []MailMessageHook
+var mailMessageBeforeUpsertHooks []MailMessageHook
+
+var mailMessageAfterInsertHooks []MailMessageHook
+var mailMessageAfterSelectHooks []MailMessageHook
+var mailMessageAfterUpdateHooks []MailMessageHook
+var mailMessageAfterDeleteHooks []MailMessageHook
+var mailMessageAfterUpsertHooks []MailMessageHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MailMessage) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailMessageBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MailMessage) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailMessageBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MailMessage) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailMessageBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MailMessage) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailMessageBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MailMessage) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailMessageAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MailMessage) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailMessageAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MailMessage) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailMessageAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MailMessage) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailMessageAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MailMessage) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailMessageAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMailMessageHook registers your hook function for all future operations.
+func AddMailMessageHook(hookPoint boil.HookPoint, mailMessageHook MailMessageHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		mailMessageBeforeInsertHooks = append(mailMessageBeforeInsertHooks, mailMessageHook)
+	case boil.BeforeUpdateHook:
+		mailMessageBeforeUpdateHooks = append(mailMessageBeforeUpdateHooks, mailMessageHook)
+	case boil.BeforeDeleteHook:
+		mailMessageBeforeDeleteHooks = append(mailMessageBeforeDeleteHooks, mailMessageHook)
+	case boil.BeforeUpsertHook:
+		mailMessageBeforeUpsertHooks = append(mailMessageBeforeUpsertHooks, mailMessageHook)
+	case boil.AfterInsertHook:
+		mailMessageAfterInsertHooks = append(mailMessageAfterInsertHooks, mailMessageHook)
+	case boil.AfterSelectHook:
+		mailMessageAfterSelectHooks = append(mailMessageAfterSelectHooks, mailMessageHook)
+	case boil.AfterUpdateHook:
+		mailMessageAfterUpdateHooks = append(mailMessageAfterUpdateHooks, mailMessageHook)
+	case boil.AfterDeleteHook:
+		mailMessageAfterDeleteHooks = append(mailMessageAfterDeleteHooks, mailMessageHook)
+	case boil.AfterUpsertHook:
+		mailMessageAfterUpsertHooks = append(mailMessageAfterUpsertHooks, mailMessageHook)
+	}
+}
+
+// One returns a single mailMessage record from the query.
+func (q mailMessageQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MailMessage, error) {
+	o := &MailMessage{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for mail_messages")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MailMessage records from the query.
+func (q mailMessageQuery) All(ctx context.Context, exec boil.ContextExecutor) (MailMessageSlice, error) {
+	var o []*MailMessage
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MailMessage slice")
+	}
+
+	if len(mailMessageAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MailMessage records in the query.
+func (q mailMessageQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count mail_messages rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q mailMessageQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if mail_messages exists")
+	}
+
+	return count > 0, nil
+}
+
+// MailMessages retrieves all the records using an executor.
+func MailMessages(mods ...qm.QueryMod) mailMessageQuery {
+	mods = append(mods, qm.From("`mail_messages`"))
+	return mailMessageQuery{NewQuery(mods...)}
+}
+
+// FindMailMessage retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMailMessage(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*MailMessage, error) {
+	mailMessageObj := &MailMessage{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `mail_messages` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, mailMessageObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from mail_messages")
+	}
+
+	return mailMessageObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MailMessage) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no mail_messages provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mailMessageColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	mailMessageInsertCacheMut.RLock()
+	cache, cached := mailMessageInsertCache[key]
+	mailMessageInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			mailMessageColumns,
+			mailMessageColumnsWithDefault,
+			mailMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mailMessageType, mailMessageMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(mailMessageType, mailMessageMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `mail_messages` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `mail_messages` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `mail_messages` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, mailMessagePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into mail_messages")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == mailMessageMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for mail_messages")
+	}
+
+CacheNoHooks:
+	if !cached {
+		mailMessageInsertCacheMut.Lock()
+		mailMessageInsertCache[key] = cache
+		mailMessageInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MailMessage.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MailMessage) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	mailMessageUpdateCacheMut.RLock()
+	cache, cached := mailMessageUpdateCache[key]
+	mailMessageUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			mailMessageColumns,
+			mailMessagePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update mail_messages, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `mail_messages` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, mailMessagePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(mailMessageType, mailMessageMapping, append(wl, mailMessagePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update mail_messages row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for mail_messages")
+	}
+
+	if !cached {
+		mailMessageUpdateCacheMut.Lock()
+		mailMessageUpdateCache[key] = cache
+		mailMessageUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q mailMessageQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for mail_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for mail_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MailMessageSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `mail_messages` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, mailMessagePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in mailMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all mailMessage")
+	}
+	return rowsAff, nil
+}
+
+var mySQLMailMessageUniqueColumns = []string{
+	"id",
+	"idempotency_key",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MailMessage) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no mail_messages provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mailMessageColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLMailMessageUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	mailMessageUpsertCacheMut.RLock()
+	cache, cached := mailMessageUpsertCache[key]
+	mailMessageUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			mailMessageColumns,
+			mailMessageColumnsWithDefault,
+			mailMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			mailMessageColumns,
+			mailMessagePrimaryKeyColumns,
+		)
+
+		if len(update) == 0 {
+			return errors.New("models: unable to upsert mail_messages, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "mail_messages", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `mail_messages` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mailMessageType, mailMessageMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(mailMessageType, mailMessageMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for mail_messages")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == mailMessageMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(mailMessageType, mailMessageMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for mail_messages")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for mail_messages")
+	}
+
+CacheNoHooks:
+	if !cached {
+		mailMessageUpsertCacheMut.Lock()
+		mailMessageUpsertCache[key] = cache
+		mailMessageUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MailMessage record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MailMessage) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MailMessage provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), mailMessagePrimaryKeyMapping)
+	sql := "DELETE FROM `mail_messages` WHERE `id`=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from mail_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for mail_messages")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q mailMessageQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no mailMessageQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from mail_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for mail_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MailMessageSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MailMessage slice provided for delete all")
+	}
+
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(mailMessageBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `mail_messages` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, mailMessagePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from mailMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for mail_messages")
+	}
+
+	if len(mailMessageAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MailMessage) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMailMessage(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MailMessageSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MailMessageSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `mail_messages`.* FROM `mail_messages` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, mailMessagePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MailMessageSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MailMessageExists checks if the MailMessage row exists.
+func MailMessageExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `mail_messages` where `id`=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if mail_messages exists")
+	}
+
+	return exists, nil
+}
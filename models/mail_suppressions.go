@@ -0,0 +1,983 @@
+// Code generated by SQLBoiler (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+	"github.com/volatiletech/sqlboiler/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/strmangle"
+)
+
+// MailSuppression is an object representing the database table.
+type MailSuppression struct {
+	ID         int         `gqlgen:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Email      string      `gqlgen:"email" boil:"email" json:"email" toml:"email" yaml:"email"`
+	Reason     string      `gqlgen:"reason" boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	Detail     null.String `gqlgen:"detail" boil:"detail" json:"detail,omitempty" toml:"detail" yaml:"detail,omitempty"`
+	FeedbackID null.String `gqlgen:"feedback_id" boil:"feedback_id" json:"feedback_id,omitempty" toml:"feedback_id" yaml:"feedback_id,omitempty"`
+	CreatedAt  time.Time   `gqlgen:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time   `gqlgen:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *mailSuppressionR `gqlgen:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L mailSuppressionL  `gqlgen:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MailSuppressionColumns = struct {
+	ID         string
+	Email      string
+	Reason     string
+	Detail     string
+	FeedbackID string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "id",
+	Email:      "email",
+	Reason:     "reason",
+	Detail:     "detail",
+	FeedbackID: "feedback_id",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+// Generated where
+
+var MailSuppressionWhere = struct {
+	ID         whereHelperint
+	Email      whereHelperstring
+	Reason     whereHelperstring
+	Detail     whereHelpernull_String
+	FeedbackID whereHelpernull_String
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperint{field: `id`},
+	Email:      whereHelperstring{field: `email`},
+	Reason:     whereHelperstring{field: `reason`},
+	Detail:     whereHelpernull_String{field: `detail`},
+	FeedbackID: whereHelpernull_String{field: `feedback_id`},
+	CreatedAt:  whereHelpertime_Time{field: `created_at`},
+	UpdatedAt:  whereHelpertime_Time{field: `updated_at`},
+}
+
+// MailSuppressionRels is where relationship names are stored.
+var MailSuppressionRels = struct {
+}{}
+
+// mailSuppressionR is where relationships are stored.
+type mailSuppressionR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*mailSuppressionR) NewStruct() *mailSuppressionR {
+	return &mailSuppressionR{}
+}
+
+// mailSuppressionL is where Load methods for each relationship are stored.
+type mailSuppressionL struct{}
+
+var (
+	mailSuppressionColumns               = []string{"id", "email", "reason", "detail", "feedback_id", "created_at", "updated_at"}
+	mailSuppressionColumnsWithoutDefault = []string{"email", "reason", "detail", "feedback_id", "created_at", "updated_at"}
+	mailSuppressionColumnsWithDefault    = []string{"id"}
+	mailSuppressionPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// MailSuppressionSlice is an alias for a slice of pointers to MailSuppression.
+	// This should generally be used opposed to []MailSuppression.
+	MailSuppressionSlice []*MailSuppression
+	// MailSuppressionHook is the signature for custom MailSuppression hook methods
+	MailSuppressionHook func(context.Context, boil.ContextExecutor, *MailSuppression) error
+
+	mailSuppressionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	mailSuppressionType                 = reflect.TypeOf(&MailSuppression{})
+	mailSuppressionMapping              = queries.MakeStructMapping(mailSuppressionType)
+	mailSuppressionPrimaryKeyMapping, _ = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, mailSuppressionPrimaryKeyColumns)
+	mailSuppressionInsertCacheMut       sync.RWMutex
+	mailSuppressionInsertCache          = make(map[string]insertCache)
+	mailSuppressionUpdateCacheMut       sync.RWMutex
+	mailSuppressionUpdateCache          = make(map[string]updateCache)
+	mailSuppressionUpsertCacheMut       sync.RWMutex
+	mailSuppressionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var mailSuppressionBeforeInsertHooks []MailSuppressionHook
+var mailSuppressionBeforeUpdateHooks []MailSuppressionHook
+var mailSuppressionBeforeDeleteHooks []MailSuppressionHook
+var mailSuppressionBeforeUpsertHooks []MailSuppressionHook
+
+var mailSuppressionAfterInsertHooks []MailSuppressionHook
+var mailSuppressionAfterSelectHooks []MailSuppressionHook
+var mailSuppressionAfterUpdateHooks []MailSuppressionHook
+var mailSuppressionAfterDeleteHooks []MailSuppressionHook
+var mailSuppressionAfterUpsertHooks []MailSuppressionHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MailSuppression) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailSuppressionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MailSuppression) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailSuppressionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MailSuppression) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailSuppressionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MailSuppression) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailSuppressionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MailSuppression) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailSuppressionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MailSuppression) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailSuppressionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MailSuppression) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailSuppressionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MailSuppression) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailSuppressionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MailSuppression) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mailSuppressionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMailSuppressionHook registers your hook function for all future operations.
+func AddMailSuppressionHook(hookPoint boil.HookPoint, mailSuppressionHook MailSuppressionHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		mailSuppressionBeforeInsertHooks = append(mailSuppressionBeforeInsertHooks, mailSuppressionHook)
+	case boil.BeforeUpdateHook:
+		mailSuppressionBeforeUpdateHooks = append(mailSuppressionBeforeUpdateHooks, mailSuppressionHook)
+	case boil.BeforeDeleteHook:
+		mailSuppressionBeforeDeleteHooks = append(mailSuppressionBeforeDeleteHooks, mailSuppressionHook)
+	case boil.BeforeUpsertHook:
+		mailSuppressionBeforeUpsertHooks = append(mailSuppressionBeforeUpsertHooks, mailSuppressionHook)
+	case boil.AfterInsertHook:
+		mailSuppressionAfterInsertHooks = append(mailSuppressionAfterInsertHooks, mailSuppressionHook)
+	case boil.AfterSelectHook:
+		mailSuppressionAfterSelectHooks = append(mailSuppressionAfterSelectHooks, mailSuppressionHook)
+	case boil.AfterUpdateHook:
+		mailSuppressionAfterUpdateHooks = append(mailSuppressionAfterUpdateHooks, mailSuppressionHook)
+	case boil.AfterDeleteHook:
+		mailSuppressionAfterDeleteHooks = append(mailSuppressionAfterDeleteHooks, mailSuppressionHook)
+	case boil.AfterUpsertHook:
+		mailSuppressionAfterUpsertHooks = append(mailSuppressionAfterUpsertHooks, mailSuppressionHook)
+	}
+}
+
+// One returns a single mailSuppression record from the query.
+func (q mailSuppressionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MailSuppression, error) {
+	o := &MailSuppression{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for mail_suppressions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MailSuppression records from the query.
+func (q mailSuppressionQuery) All(ctx context.Context, exec boil.ContextExecutor) (MailSuppressionSlice, error) {
+	var o []*MailSuppression
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MailSuppression slice")
+	}
+
+	if len(mailSuppressionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MailSuppression records in the query.
+func (q mailSuppressionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count mail_suppressions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q mailSuppressionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if mail_suppressions exists")
+	}
+
+	return count > 0, nil
+}
+
+// MailSuppressions retrieves all the records using an executor.
+func MailSuppressions(mods ...qm.QueryMod) mailSuppressionQuery {
+	mods = append(mods, qm.From("`mail_suppressions`"))
+	return mailSuppressionQuery{NewQuery(mods...)}
+}
+
+// FindMailSuppression retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMailSuppression(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*MailSuppression, error) {
+	mailSuppressionObj := &MailSuppression{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `mail_suppressions` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, mailSuppressionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from mail_suppressions")
+	}
+
+	return mailSuppressionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MailSuppression) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no mail_suppressions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mailSuppressionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	mailSuppressionInsertCacheMut.RLock()
+	cache, cached := mailSuppressionInsertCache[key]
+	mailSuppressionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			mailSuppressionColumns,
+			mailSuppressionColumnsWithDefault,
+			mailSuppressionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `mail_suppressions` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `mail_suppressions` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `mail_suppressions` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, mailSuppressionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into mail_suppressions")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == mailSuppressionMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for mail_suppressions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		mailSuppressionInsertCacheMut.Lock()
+		mailSuppressionInsertCache[key] = cache
+		mailSuppressionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MailSuppression.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MailSuppression) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	mailSuppressionUpdateCacheMut.RLock()
+	cache, cached := mailSuppressionUpdateCache[key]
+	mailSuppressionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			mailSuppressionColumns,
+			mailSuppressionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update mail_suppressions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `mail_suppressions` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, mailSuppressionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, append(wl, mailSuppressionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update mail_suppressions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for mail_suppressions")
+	}
+
+	if !cached {
+		mailSuppressionUpdateCacheMut.Lock()
+		mailSuppressionUpdateCache[key] = cache
+		mailSuppressionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q mailSuppressionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for mail_suppressions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for mail_suppressions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MailSuppressionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailSuppressionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `mail_suppressions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, mailSuppressionPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in mailSuppression slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all mailSuppression")
+	}
+	return rowsAff, nil
+}
+
+var mySQLMailSuppressionUniqueColumns = []string{
+	"id",
+	"email",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MailSuppression) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no mail_suppressions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mailSuppressionColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLMailSuppressionUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	mailSuppressionUpsertCacheMut.RLock()
+	cache, cached := mailSuppressionUpsertCache[key]
+	mailSuppressionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			mailSuppressionColumns,
+			mailSuppressionColumnsWithDefault,
+			mailSuppressionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			mailSuppressionColumns,
+			mailSuppressionPrimaryKeyColumns,
+		)
+
+		if len(update) == 0 {
+			return errors.New("models: unable to upsert mail_suppressions, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "mail_suppressions", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `mail_suppressions` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for mail_suppressions")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == mailSuppressionMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for mail_suppressions")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for mail_suppressions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		mailSuppressionUpsertCacheMut.Lock()
+		mailSuppressionUpsertCache[key] = cache
+		mailSuppressionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MailSuppression record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MailSuppression) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MailSuppression provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), mailSuppressionPrimaryKeyMapping)
+	sql := "DELETE FROM `mail_suppressions` WHERE `id`=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from mail_suppressions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for mail_suppressions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q mailSuppressionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no mailSuppressionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from mail_suppressions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for mail_suppressions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MailSuppressionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MailSuppression slice provided for delete all")
+	}
+
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(mailSuppressionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailSuppressionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `mail_suppressions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, mailSuppressionPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from mailSuppression slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for mail_suppressions")
+	}
+
+	if len(mailSuppressionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MailSuppression) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMailSuppression(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MailSuppressionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MailSuppressionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailSuppressionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `mail_suppressions`.* FROM `mail_suppressions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, mailSuppressionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MailSuppressionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MailSuppressionExists checks if the MailSuppression row exists.
+func MailSuppressionExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `mail_suppressions` where `id`=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if mail_suppressions exists")
+	}
+
+	return exists, nil
+}
@@ -1,9 +1,13 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/shufo/go-graphql-boilerplate/upload"
 	"github.com/volatiletech/null"
 )
 
@@ -46,6 +50,59 @@ func UnmarshalNullableTime(v interface{}) (null.Time, error) {
 	return null.Time{Time: t}, err
 }
 
+// MarshalNullableInt marshals NullableInt Type
+func MarshalNullableInt(ni null.Int) graphql.Marshaler {
+	if !ni.Valid {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(ni.Int)
+}
+
+// UnmarshalNullableInt unmarshals NullableInt Type
+func UnmarshalNullableInt(v interface{}) (null.Int, error) {
+	if v == nil {
+		return null.Int{Valid: false}, nil
+	}
+	i, err := graphql.UnmarshalInt(v)
+	return null.IntFrom(i), err
+}
+
+// MarshalNullableJSON marshals NullableJSON Type as raw JSON
+func MarshalNullableJSON(nj null.JSON) graphql.Marshaler {
+	if !nj.Valid {
+		return graphql.Null
+	}
+	return graphql.WriterFunc(func(w io.Writer) {
+		w.Write(nj.JSON)
+	})
+}
+
+// UnmarshalNullableJSON unmarshals NullableJSON Type
+func UnmarshalNullableJSON(v interface{}) (null.JSON, error) {
+	if v == nil {
+		return null.JSON{Valid: false}, nil
+	}
+	b, err := json.Marshal(v)
+	return null.JSONFrom(b), err
+}
+
+// MarshalUpload marshals Upload Type. Upload is an input only scalar.
+func MarshalUpload(id upload.ID) graphql.Marshaler {
+	return graphql.Null
+}
+
+// UnmarshalUpload unmarshals the id which upload.Middleware set in the variables.
+// The file is looked up by upload.FileForContext with the context of the request.
+func UnmarshalUpload(v interface{}) (upload.ID, error) {
+	id, ok := v.(string)
+
+	if !ok {
+		return "", fmt.Errorf("%T is not a file", v)
+	}
+
+	return upload.ID(id), nil
+}
+
 type Ownable interface {
 	OwnerID() *int
 }
@@ -53,3 +110,7 @@ type Ownable interface {
 func (u User) OwnerID() *int {
 	return &u.ID
 }
+
+func (ap AuthenticationProvider) OwnerID() *int {
+	return &ap.UserID
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Type names of nodes used in global IDs
+const (
+	NodeTypeUser                   = "User"
+	NodeTypeAuthenticationProvider = "AuthenticationProvider"
+	NodeTypePasswordReset          = "PasswordReset"
+)
+
+// GlobalID returns the opaque ID which is unique across types
+func GlobalID(typ string, id int) string {
+	return base64.StdEncoding.EncodeToString([]byte(typ + ":" + strconv.Itoa(id)))
+}
+
+// ParseGlobalID returns the type name and the primary key of the global ID
+func ParseGlobalID(gid string) (string, int, error) {
+	b, err := base64.StdEncoding.DecodeString(gid)
+
+	if err != nil {
+		return "", 0, err
+	}
+
+	parts := strings.SplitN(string(b), ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" {
+		return "", 0, errors.New("invalid global id")
+	}
+
+	id, err := strconv.Atoi(parts[1])
+
+	if err != nil {
+		return "", 0, err
+	}
+
+	return parts[0], id, nil
+}
+
+func (User) IsNode()                   {}
+func (AuthenticationProvider) IsNode() {}
+func (PasswordReset) IsNode()          {}
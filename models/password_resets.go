@@ -26,7 +26,7 @@ import (
 type PasswordReset struct {
 	ID                       int         `gqlgen:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
 	AuthenticationProviderID int         `gqlgen:"authentication_provider_id" boil:"authentication_provider_id" json:"authentication_provider_id" toml:"authentication_provider_id" yaml:"authentication_provider_id"`
-	PasswordResetToken       null.String `gqlgen:"password_reset_token" boil:"password_reset_token" json:"password_reset_token,omitempty" toml:"password_reset_token" yaml:"password_reset_token,omitempty"`
+	TokenHash                null.String `gqlgen:"token_hash" boil:"token_hash" json:"token_hash,omitempty" toml:"token_hash" yaml:"token_hash,omitempty"`
 	Status                   null.String `gqlgen:"status" boil:"status" json:"status,omitempty" toml:"status" yaml:"status,omitempty"`
 	ExpiresAt                null.Time   `gqlgen:"expires_at" boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
 	CreatedAt                null.Time   `gqlgen:"created_at" boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
@@ -39,7 +39,7 @@ type PasswordReset struct {
 var PasswordResetColumns = struct {
 	ID                       string
 	AuthenticationProviderID string
-	PasswordResetToken       string
+	TokenHash                string
 	Status                   string
 	ExpiresAt                string
 	CreatedAt                string
@@ -47,7 +47,7 @@ var PasswordResetColumns = struct {
 }{
 	ID:                       "id",
 	AuthenticationProviderID: "authentication_provider_id",
-	PasswordResetToken:       "password_reset_token",
+	TokenHash:                "token_hash",
 	Status:                   "status",
 	ExpiresAt:                "expires_at",
 	CreatedAt:                "created_at",
@@ -59,7 +59,7 @@ var PasswordResetColumns = struct {
 var PasswordResetWhere = struct {
 	ID                       whereHelperint
 	AuthenticationProviderID whereHelperint
-	PasswordResetToken       whereHelpernull_String
+	TokenHash                whereHelpernull_String
 	Status                   whereHelpernull_String
 	ExpiresAt                whereHelpernull_Time
 	CreatedAt                whereHelpernull_Time
@@ -67,7 +67,7 @@ var PasswordResetWhere = struct {
 }{
 	ID:                       whereHelperint{field: `id`},
 	AuthenticationProviderID: whereHelperint{field: `authentication_provider_id`},
-	PasswordResetToken:       whereHelpernull_String{field: `password_reset_token`},
+	TokenHash:                whereHelpernull_String{field: `token_hash`},
 	Status:                   whereHelpernull_String{field: `status`},
 	ExpiresAt:                whereHelpernull_Time{field: `expires_at`},
 	CreatedAt:                whereHelpernull_Time{field: `created_at`},
@@ -95,8 +95,8 @@ func (*passwordResetR) NewStruct() *passwordResetR {
 type passwordResetL struct{}
 
 var (
-	passwordResetColumns               = []string{"id", "authentication_provider_id", "password_reset_token", "status", "expires_at", "created_at", "updated_at"}
-	passwordResetColumnsWithoutDefault = []string{"authentication_provider_id", "password_reset_token", "status", "expires_at", "created_at", "updated_at"}
+	passwordResetColumns               = []string{"id", "authentication_provider_id", "token_hash", "status", "expires_at", "created_at", "updated_at"}
+	passwordResetColumnsWithoutDefault = []string{"authentication_provider_id", "token_hash", "status", "expires_at", "created_at", "updated_at"}
 	passwordResetColumnsWithDefault    = []string{"id"}
 	passwordResetPrimaryKeyColumns     = []string{"id"}
 )
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// RoleRank returns the rank of the role type defined in roles table
+func RoleRank(ctx context.Context, exec boil.ContextExecutor, role RoleType) (int, error) {
+	r, err := Roles(RoleWhere.Type.EQ(role.String())).One(ctx, exec)
+
+	if err == sql.ErrNoRows {
+		return 0, errors.Wrapf(err, "role %s is not defined in roles table", role)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return r.Rank, nil
+}
+
+// UserMaxRoleRank returns the highest rank among the roles granted to the user.
+// Global roles are always taken into account. Roles granted within an organization
+// are taken into account only when organizationID is given and matches.
+// It returns 0 if the user has no role.
+func UserMaxRoleRank(ctx context.Context, exec boil.ContextExecutor, userID int, organizationID *int) (int, error) {
+	var res struct {
+		Rank null.Int `boil:"rank"`
+	}
+
+	scope := qm.Where("`user_roles`.`organization_id` IS NULL")
+
+	if organizationID != nil {
+		scope = qm.Where("(`user_roles`.`organization_id` IS NULL OR `user_roles`.`organization_id` = ?)", *organizationID)
+	}
+
+	err := NewQuery(
+		qm.Select("MAX(`roles`.`rank`) AS `rank`"),
+		qm.From("`roles`"),
+		qm.InnerJoin("`user_roles` ON `user_roles`.`role_id` = `roles`.`id`"),
+		qm.Where("`user_roles`.`user_id` = ?", userID),
+		scope,
+	).Bind(ctx, exec, &res)
+
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+
+	return res.Rank.Int, nil
+}
+
+// UserRoleTypes returns the global role types granted to the user.
+// Roles granted within an organization are left out as they don't apply to other resources.
+func UserRoleTypes(ctx context.Context, exec boil.ContextExecutor, userID int) ([]RoleType, error) {
+	urs, err := UserRoles(
+		UserRoleWhere.UserID.EQ(userID),
+		UserRoleWhere.OrganizationID.IsNull(),
+		qm.Load("Role"),
+	).All(ctx, exec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[RoleType]bool{}
+	res := []RoleType{}
+
+	for _, ur := range urs {
+		if ur.R == nil || ur.R.Role == nil {
+			continue
+		}
+
+		rt := RoleType(ur.R.Role.Type)
+
+		if !seen[rt] {
+			seen[rt] = true
+			res = append(res, rt)
+		}
+	}
+
+	return res, nil
+}
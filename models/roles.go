@@ -26,6 +26,7 @@ import (
 type Role struct {
 	ID        int         `gqlgen:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
 	Type      string      `gqlgen:"type" boil:"type" json:"type" toml:"type" yaml:"type"`
+	Rank      int         `gqlgen:"rank" boil:"rank" json:"rank" toml:"rank" yaml:"rank"`
 	CreatedAt null.String `gqlgen:"created_at" boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
 	UpdatedAt null.String `gqlgen:"updated_at" boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 
@@ -36,11 +37,13 @@ type Role struct {
 var RoleColumns = struct {
 	ID        string
 	Type      string
+	Rank      string
 	CreatedAt string
 	UpdatedAt string
 }{
 	ID:        "id",
 	Type:      "type",
+	Rank:      "rank",
 	CreatedAt: "created_at",
 	UpdatedAt: "updated_at",
 }
@@ -50,11 +53,13 @@ var RoleColumns = struct {
 var RoleWhere = struct {
 	ID        whereHelperint
 	Type      whereHelperstring
+	Rank      whereHelperint
 	CreatedAt whereHelpernull_String
 	UpdatedAt whereHelpernull_String
 }{
 	ID:        whereHelperint{field: `id`},
 	Type:      whereHelperstring{field: `type`},
+	Rank:      whereHelperint{field: `rank`},
 	CreatedAt: whereHelpernull_String{field: `created_at`},
 	UpdatedAt: whereHelpernull_String{field: `updated_at`},
 }
@@ -80,9 +85,9 @@ func (*roleR) NewStruct() *roleR {
 type roleL struct{}
 
 var (
-	roleColumns               = []string{"id", "type", "created_at", "updated_at"}
+	roleColumns               = []string{"id", "type", "rank", "created_at", "updated_at"}
 	roleColumnsWithoutDefault = []string{"type", "created_at", "updated_at"}
-	roleColumnsWithDefault    = []string{"id"}
+	roleColumnsWithDefault    = []string{"id", "rank"}
 	rolePrimaryKeyColumns     = []string{"id"}
 )
 
@@ -24,59 +24,64 @@ import (
 
 // UserRole is an object representing the database table.
 type UserRole struct {
-	ID        int       `gqlgen:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID    int       `gqlgen:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	RoleID    int       `gqlgen:"role_id" boil:"role_id" json:"role_id" toml:"role_id" yaml:"role_id"`
-	CreatedAt null.Time `gqlgen:"created_at" boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
-	UpdatedAt null.Time `gqlgen:"updated_at" boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	ID             int       `gqlgen:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID         int       `gqlgen:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	RoleID         int       `gqlgen:"role_id" boil:"role_id" json:"role_id" toml:"role_id" yaml:"role_id"`
+	OrganizationID null.Int  `gqlgen:"organization_id" boil:"organization_id" json:"organization_id,omitempty" toml:"organization_id" yaml:"organization_id,omitempty"`
+	CreatedAt      null.Time `gqlgen:"created_at" boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt      null.Time `gqlgen:"updated_at" boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 
 	R *userRoleR `gqlgen:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userRoleL  `gqlgen:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserRoleColumns = struct {
-	ID        string
-	UserID    string
-	RoleID    string
-	CreatedAt string
-	UpdatedAt string
+	ID             string
+	UserID         string
+	RoleID         string
+	OrganizationID string
+	CreatedAt      string
+	UpdatedAt      string
 }{
-	ID:        "id",
-	UserID:    "user_id",
-	RoleID:    "role_id",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	ID:             "id",
+	UserID:         "user_id",
+	RoleID:         "role_id",
+	OrganizationID: "organization_id",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
 }
 
 // Generated where
 
 var UserRoleWhere = struct {
-	ID        whereHelperint
-	UserID    whereHelperint
-	RoleID    whereHelperint
-	CreatedAt whereHelpernull_Time
-	UpdatedAt whereHelpernull_Time
+	ID             whereHelperint
+	UserID         whereHelperint
+	RoleID         whereHelperint
+	OrganizationID whereHelpernull_Int
+	CreatedAt      whereHelpernull_Time
+	UpdatedAt      whereHelpernull_Time
 }{
-	ID:        whereHelperint{field: `id`},
-	UserID:    whereHelperint{field: `user_id`},
-	RoleID:    whereHelperint{field: `role_id`},
-	CreatedAt: whereHelpernull_Time{field: `created_at`},
-	UpdatedAt: whereHelpernull_Time{field: `updated_at`},
+	ID:             whereHelperint{field: `id`},
+	UserID:         whereHelperint{field: `user_id`},
+	RoleID:         whereHelperint{field: `role_id`},
+	OrganizationID: whereHelpernull_Int{field: `organization_id`},
+	CreatedAt:      whereHelpernull_Time{field: `created_at`},
+	UpdatedAt:      whereHelpernull_Time{field: `updated_at`},
 }
 
 // UserRoleRels is where relationship names are stored.
 var UserRoleRels = struct {
-	User string
 	Role string
+	User string
 }{
-	User: "User",
 	Role: "Role",
+	User: "User",
 }
 
 // userRoleR is where relationships are stored.
 type userRoleR struct {
-	User *User
 	Role *Role
+	User *User
 }
 
 // NewStruct creates a new relationship struct
@@ -88,8 +93,8 @@ func (*userRoleR) NewStruct() *userRoleR {
 type userRoleL struct{}
 
 var (
-	userRoleColumns               = []string{"id", "user_id", "role_id", "created_at", "updated_at"}
-	userRoleColumnsWithoutDefault = []string{"user_id", "role_id", "created_at", "updated_at"}
+	userRoleColumns               = []string{"id", "user_id", "role_id", "organization_id", "created_at", "updated_at"}
+	userRoleColumnsWithoutDefault = []string{"user_id", "role_id", "organization_id", "created_at", "updated_at"}
 	userRoleColumnsWithDefault    = []string{"id"}
 	userRolePrimaryKeyColumns     = []string{"id"}
 )
@@ -369,37 +374,37 @@ func (q userRoleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (b
 	return count > 0, nil
 }
 
-// User pointed to by the foreign key.
-func (o *UserRole) User(mods ...qm.QueryMod) userQuery {
+// Role pointed to by the foreign key.
+func (o *UserRole) Role(mods ...qm.QueryMod) roleQuery {
 	queryMods := []qm.QueryMod{
-		qm.Where("id=?", o.UserID),
+		qm.Where("id=?", o.RoleID),
 	}
 
 	queryMods = append(queryMods, mods...)
 
-	query := Users(queryMods...)
-	queries.SetFrom(query.Query, "`users`")
+	query := Roles(queryMods...)
+	queries.SetFrom(query.Query, "`roles`")
 
 	return query
 }
 
-// Role pointed to by the foreign key.
-func (o *UserRole) Role(mods ...qm.QueryMod) roleQuery {
+// User pointed to by the foreign key.
+func (o *UserRole) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
-		qm.Where("id=?", o.RoleID),
+		qm.Where("id=?", o.UserID),
 	}
 
 	queryMods = append(queryMods, mods...)
 
-	query := Roles(queryMods...)
-	queries.SetFrom(query.Query, "`roles`")
+	query := Users(queryMods...)
+	queries.SetFrom(query.Query, "`users`")
 
 	return query
 }
 
-// LoadUser allows an eager lookup of values, cached into the
+// LoadRole allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (userRoleL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserRole interface{}, mods queries.Applicator) error {
+func (userRoleL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserRole interface{}, mods queries.Applicator) error {
 	var slice []*UserRole
 	var object *UserRole
 
@@ -414,7 +419,7 @@ func (userRoleL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular
 		if object.R == nil {
 			object.R = &userRoleR{}
 		}
-		args = append(args, object.UserID)
+		args = append(args, object.RoleID)
 
 	} else {
 	Outer:
@@ -424,12 +429,12 @@ func (userRoleL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular
 			}
 
 			for _, a := range args {
-				if a == obj.UserID {
+				if a == obj.RoleID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.UserID)
+			args = append(args, obj.RoleID)
 
 		}
 	}
@@ -438,26 +443,26 @@ func (userRoleL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular
 		return nil
 	}
 
-	query := NewQuery(qm.From(`users`), qm.WhereIn(`id in ?`, args...))
+	query := NewQuery(qm.From(`roles`), qm.WhereIn(`id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load User")
+		return errors.Wrap(err, "failed to eager load Role")
 	}
 
-	var resultSlice []*User
+	var resultSlice []*Role
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice User")
+		return errors.Wrap(err, "failed to bind eager loaded slice Role")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for users")
+		return errors.Wrap(err, "failed to close results of eager load for roles")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
 	}
 
 	if len(userRoleAfterSelectHooks) != 0 {
@@ -474,9 +479,9 @@ func (userRoleL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.User = foreign
+		object.R.Role = foreign
 		if foreign.R == nil {
-			foreign.R = &userR{}
+			foreign.R = &roleR{}
 		}
 		foreign.R.UserRoles = append(foreign.R.UserRoles, object)
 		return nil
@@ -484,10 +489,10 @@ func (userRoleL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.UserID == foreign.ID {
-				local.R.User = foreign
+			if local.RoleID == foreign.ID {
+				local.R.Role = foreign
 				if foreign.R == nil {
-					foreign.R = &userR{}
+					foreign.R = &roleR{}
 				}
 				foreign.R.UserRoles = append(foreign.R.UserRoles, local)
 				break
@@ -498,9 +503,9 @@ func (userRoleL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadRole allows an eager lookup of values, cached into the
+// LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (userRoleL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserRole interface{}, mods queries.Applicator) error {
+func (userRoleL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserRole interface{}, mods queries.Applicator) error {
 	var slice []*UserRole
 	var object *UserRole
 
@@ -515,7 +520,7 @@ func (userRoleL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular
 		if object.R == nil {
 			object.R = &userRoleR{}
 		}
-		args = append(args, object.RoleID)
+		args = append(args, object.UserID)
 
 	} else {
 	Outer:
@@ -525,12 +530,12 @@ func (userRoleL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular
 			}
 
 			for _, a := range args {
-				if a == obj.RoleID {
+				if a == obj.UserID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.RoleID)
+			args = append(args, obj.UserID)
 
 		}
 	}
@@ -539,26 +544,26 @@ func (userRoleL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular
 		return nil
 	}
 
-	query := NewQuery(qm.From(`roles`), qm.WhereIn(`id in ?`, args...))
+	query := NewQuery(qm.From(`users`), qm.WhereIn(`id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load Role")
+		return errors.Wrap(err, "failed to eager load User")
 	}
 
-	var resultSlice []*Role
+	var resultSlice []*User
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Role")
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for roles")
+		return errors.Wrap(err, "failed to close results of eager load for users")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
 	}
 
 	if len(userRoleAfterSelectHooks) != 0 {
@@ -575,9 +580,9 @@ func (userRoleL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.Role = foreign
+		object.R.User = foreign
 		if foreign.R == nil {
-			foreign.R = &roleR{}
+			foreign.R = &userR{}
 		}
 		foreign.R.UserRoles = append(foreign.R.UserRoles, object)
 		return nil
@@ -585,10 +590,10 @@ func (userRoleL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.RoleID == foreign.ID {
-				local.R.Role = foreign
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
 				if foreign.R == nil {
-					foreign.R = &roleR{}
+					foreign.R = &userR{}
 				}
 				foreign.R.UserRoles = append(foreign.R.UserRoles, local)
 				break
@@ -599,10 +604,10 @@ func (userRoleL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// SetUser of the userRole to the related item.
-// Sets o.R.User to related.
+// SetRole of the userRole to the related item.
+// Sets o.R.Role to related.
 // Adds o to related.R.UserRoles.
-func (o *UserRole) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+func (o *UserRole) SetRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
 	var err error
 	if insert {
 		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
@@ -612,7 +617,7 @@ func (o *UserRole) SetUser(ctx context.Context, exec boil.ContextExecutor, inser
 
 	updateQuery := fmt.Sprintf(
 		"UPDATE `user_roles` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.SetParamNames("`", "`", 0, []string{"role_id"}),
 		strmangle.WhereClause("`", "`", 0, userRolePrimaryKeyColumns),
 	)
 	values := []interface{}{related.ID, o.ID}
@@ -626,17 +631,17 @@ func (o *UserRole) SetUser(ctx context.Context, exec boil.ContextExecutor, inser
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	o.UserID = related.ID
+	o.RoleID = related.ID
 	if o.R == nil {
 		o.R = &userRoleR{
-			User: related,
+			Role: related,
 		}
 	} else {
-		o.R.User = related
+		o.R.Role = related
 	}
 
 	if related.R == nil {
-		related.R = &userR{
+		related.R = &roleR{
 			UserRoles: UserRoleSlice{o},
 		}
 	} else {
@@ -646,10 +651,10 @@ func (o *UserRole) SetUser(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
-// SetRole of the userRole to the related item.
-// Sets o.R.Role to related.
+// SetUser of the userRole to the related item.
+// Sets o.R.User to related.
 // Adds o to related.R.UserRoles.
-func (o *UserRole) SetRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
+func (o *UserRole) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
 	var err error
 	if insert {
 		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
@@ -659,7 +664,7 @@ func (o *UserRole) SetRole(ctx context.Context, exec boil.ContextExecutor, inser
 
 	updateQuery := fmt.Sprintf(
 		"UPDATE `user_roles` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"role_id"}),
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
 		strmangle.WhereClause("`", "`", 0, userRolePrimaryKeyColumns),
 	)
 	values := []interface{}{related.ID, o.ID}
@@ -673,17 +678,17 @@ func (o *UserRole) SetRole(ctx context.Context, exec boil.ContextExecutor, inser
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	o.RoleID = related.ID
+	o.UserID = related.ID
 	if o.R == nil {
 		o.R = &userRoleR{
-			Role: related,
+			User: related,
 		}
 	} else {
-		o.R.Role = related
+		o.R.User = related
 	}
 
 	if related.R == nil {
-		related.R = &roleR{
+		related.R = &userR{
 			UserRoles: UserRoleSlice{o},
 		}
 	} else {
@@ -27,6 +27,7 @@ type User struct {
 	ID        int         `gqlgen:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
 	Username  null.String `gqlgen:"username" boil:"username" json:"username,omitempty" toml:"username" yaml:"username,omitempty"`
 	Email     null.String `gqlgen:"email" boil:"email" json:"email,omitempty" toml:"email" yaml:"email,omitempty"`
+	AvatarKey null.String `gqlgen:"avatar_key" boil:"avatar_key" json:"avatar_key,omitempty" toml:"avatar_key" yaml:"avatar_key,omitempty"`
 	CreatedAt null.Time   `gqlgen:"created_at" boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
 	UpdatedAt null.Time   `gqlgen:"updated_at" boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 
@@ -38,12 +39,14 @@ var UserColumns = struct {
 	ID        string
 	Username  string
 	Email     string
+	AvatarKey string
 	CreatedAt string
 	UpdatedAt string
 }{
 	ID:        "id",
 	Username:  "username",
 	Email:     "email",
+	AvatarKey: "avatar_key",
 	CreatedAt: "created_at",
 	UpdatedAt: "updated_at",
 }
@@ -54,35 +57,40 @@ var UserWhere = struct {
 	ID        whereHelperint
 	Username  whereHelpernull_String
 	Email     whereHelpernull_String
+	AvatarKey whereHelpernull_String
 	CreatedAt whereHelpernull_Time
 	UpdatedAt whereHelpernull_Time
 }{
 	ID:        whereHelperint{field: `id`},
 	Username:  whereHelpernull_String{field: `username`},
 	Email:     whereHelpernull_String{field: `email`},
+	AvatarKey: whereHelpernull_String{field: `avatar_key`},
 	CreatedAt: whereHelpernull_Time{field: `created_at`},
 	UpdatedAt: whereHelpernull_Time{field: `updated_at`},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	AuthTokens              string
-	AuthenticationProviders string
-	Profiles                string
-	UserRoles               string
+	AuthTokens                  string
+	AuthenticationProviders     string
+	Profiles                    string
+	UserNotificationPreferences string
+	UserRoles                   string
 }{
-	AuthTokens:              "AuthTokens",
-	AuthenticationProviders: "AuthenticationProviders",
-	Profiles:                "Profiles",
-	UserRoles:               "UserRoles",
+	AuthTokens:                  "AuthTokens",
+	AuthenticationProviders:     "AuthenticationProviders",
+	Profiles:                    "Profiles",
+	UserNotificationPreferences: "UserNotificationPreferences",
+	UserRoles:                   "UserRoles",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	AuthTokens              AuthTokenSlice
-	AuthenticationProviders AuthenticationProviderSlice
-	Profiles                ProfileSlice
-	UserRoles               UserRoleSlice
+	AuthTokens                  AuthTokenSlice
+	AuthenticationProviders     AuthenticationProviderSlice
+	Profiles                    ProfileSlice
+	UserNotificationPreferences UserNotificationPreferenceSlice
+	UserRoles                   UserRoleSlice
 }
 
 // NewStruct creates a new relationship struct
@@ -94,8 +102,8 @@ func (*userR) NewStruct() *userR {
 type userL struct{}
 
 var (
-	userColumns               = []string{"id", "username", "email", "created_at", "updated_at"}
-	userColumnsWithoutDefault = []string{"username", "email", "created_at", "updated_at"}
+	userColumns               = []string{"id", "username", "email", "avatar_key", "created_at", "updated_at"}
+	userColumnsWithoutDefault = []string{"username", "email", "avatar_key", "created_at", "updated_at"}
 	userColumnsWithDefault    = []string{"id"}
 	userPrimaryKeyColumns     = []string{"id"}
 )
@@ -438,6 +446,27 @@ func (o *User) Profiles(mods ...qm.QueryMod) profileQuery {
 	return query
 }
 
+// UserNotificationPreferences retrieves all the user_notification_preference's UserNotificationPreferences with an executor.
+func (o *User) UserNotificationPreferences(mods ...qm.QueryMod) userNotificationPreferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`user_notification_preferences`.`user_id`=?", o.ID),
+	)
+
+	query := UserNotificationPreferences(queryMods...)
+	queries.SetFrom(query.Query, "`user_notification_preferences`")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"`user_notification_preferences`.*"})
+	}
+
+	return query
+}
+
 // UserRoles retrieves all the user_role's UserRoles with an executor.
 func (o *User) UserRoles(mods ...qm.QueryMod) userRoleQuery {
 	var queryMods []qm.QueryMod
@@ -744,6 +773,101 @@ func (userL) LoadProfiles(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// LoadUserNotificationPreferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserNotificationPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`user_notification_preferences`), qm.WhereIn(`user_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_notification_preferences")
+	}
+
+	var resultSlice []*UserNotificationPreference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_notification_preferences")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_notification_preferences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_notification_preferences")
+	}
+
+	if len(userNotificationPreferenceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserNotificationPreferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userNotificationPreferenceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserNotificationPreferences = append(local.R.UserNotificationPreferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &userNotificationPreferenceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUserRoles allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (userL) LoadUserRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -998,6 +1122,59 @@ func (o *User) AddProfiles(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
+// AddUserNotificationPreferences adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserNotificationPreferences.
+// Sets related.R.User appropriately.
+func (o *User) AddUserNotificationPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserNotificationPreference) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `user_notification_preferences` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, userNotificationPreferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserNotificationPreferences: related,
+		}
+	} else {
+		o.R.UserNotificationPreferences = append(o.R.UserNotificationPreferences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userNotificationPreferenceR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddUserRoles adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.UserRoles.
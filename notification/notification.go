@@ -0,0 +1,191 @@
+// Package notification keeps the notification preferences of users, which decide
+// the categories of notifications they receive on each channel
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// Category is the kind of notifications
+type Category string
+
+// Categories of the notifications
+const (
+	// CategoryAccount is the transactional notifications like password resets
+	CategoryAccount        Category = "account"
+	CategoryProductUpdates Category = "product_updates"
+	CategoryMarketing      Category = "marketing"
+)
+
+// Categories are all the categories in the order shown to users
+var Categories = []Category{CategoryAccount, CategoryProductUpdates, CategoryMarketing}
+
+// Transactional reports whether the notifications are required to use the account.
+// They are always sent and users can not opt out of them.
+func (c Category) Transactional() bool {
+	return c == CategoryAccount
+}
+
+// DefaultEnabled reports whether the notifications are sent unless the user opts out.
+// Marketing notifications are sent only to the users who opt in.
+func (c Category) DefaultEnabled() bool {
+	return c != CategoryMarketing
+}
+
+// Valid reports whether the category is known
+func (c Category) Valid() bool {
+	for _, category := range Categories {
+		if c == category {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Channel is the way notifications are delivered
+type Channel string
+
+// Channels of the notifications
+const (
+	ChannelEmail Channel = "email"
+	ChannelPush  Channel = "push"
+)
+
+// Channels are all the channels
+var Channels = []Channel{ChannelEmail, ChannelPush}
+
+// Valid reports whether the channel is known
+func (c Channel) Valid() bool {
+	return c == ChannelEmail || c == ChannelPush
+}
+
+// Preference is whether the user receives the category of notifications on the channel
+type Preference struct {
+	Category Category
+	Channel  Channel
+	Enabled  bool
+}
+
+var (
+	// ErrTransactional is returned when the transactional notifications are disabled
+	ErrTransactional = errors.New("notification: transactional notifications can not be disabled")
+	// ErrUnknown is returned for unknown categories or channels
+	ErrUnknown = errors.New("notification: unknown category or channel")
+)
+
+// Preferences returns the preferences of all the categories and channels of the user.
+// The defaults of the category are returned unless the user has changed them.
+func Preferences(ctx context.Context, exec boil.ContextExecutor, userID int) ([]Preference, error) {
+	rows, err := models.UserNotificationPreferences(models.UserNotificationPreferenceWhere.UserID.EQ(userID)).All(ctx, exec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stored := map[Preference]bool{}
+
+	for _, row := range rows {
+		stored[Preference{Category: Category(row.Category), Channel: Channel(row.Channel)}] = row.Enabled
+	}
+
+	prefs := make([]Preference, 0, len(Categories)*len(Channels))
+
+	for _, category := range Categories {
+		for _, channel := range Channels {
+			p := Preference{Category: category, Channel: channel}
+			enabled, ok := stored[p]
+
+			switch {
+			case category.Transactional():
+				p.Enabled = true
+			case ok:
+				p.Enabled = enabled
+			default:
+				p.Enabled = category.DefaultEnabled()
+			}
+
+			prefs = append(prefs, p)
+		}
+	}
+
+	return prefs, nil
+}
+
+// Allowed reports whether the user receives the category of notifications on the channel
+func Allowed(ctx context.Context, exec boil.ContextExecutor, userID int, category Category, channel Channel) (bool, error) {
+	if category.Transactional() {
+		return true, nil
+	}
+
+	row, err := models.UserNotificationPreferences(
+		models.UserNotificationPreferenceWhere.UserID.EQ(userID),
+		models.UserNotificationPreferenceWhere.Category.EQ(string(category)),
+		models.UserNotificationPreferenceWhere.Channel.EQ(string(channel)),
+	).One(ctx, exec)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return category.DefaultEnabled(), nil
+	case err != nil:
+		return false, err
+	}
+
+	return row.Enabled, nil
+}
+
+// Set saves the preferences of the user. The others are left as they are.
+// Transactional notifications can only be enabled.
+func Set(ctx context.Context, exec boil.ContextExecutor, userID int, prefs []Preference) error {
+	for _, p := range prefs {
+		if !p.Category.Valid() || !p.Channel.Valid() {
+			return ErrUnknown
+		}
+
+		if p.Category.Transactional() && !p.Enabled {
+			return ErrTransactional
+		}
+	}
+
+	for _, p := range prefs {
+		// transactional notifications are always enabled without the rows
+		if p.Category.Transactional() {
+			continue
+		}
+
+		row, err := models.UserNotificationPreferences(
+			models.UserNotificationPreferenceWhere.UserID.EQ(userID),
+			models.UserNotificationPreferenceWhere.Category.EQ(string(p.Category)),
+			models.UserNotificationPreferenceWhere.Channel.EQ(string(p.Channel)),
+		).One(ctx, exec)
+
+		if err == sql.ErrNoRows {
+			row = &models.UserNotificationPreference{
+				UserID:   userID,
+				Category: string(p.Category),
+				Channel:  string(p.Channel),
+			}
+		} else if err != nil {
+			return err
+		}
+
+		row.Enabled = p.Enabled
+
+		if row.ID == 0 {
+			err = row.Insert(ctx, exec, boil.Infer())
+		} else {
+			_, err = row.Update(ctx, exec, boil.Infer())
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/shufo/go-graphql-boilerplate/database"
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/translations"
+)
+
+// Notifier sends the mails of the categories users receive
+type Notifier struct {
+	db        *sql.DB
+	mailer    mail.Mailer
+	templates *mail.Templates
+	clock     clock.Clock
+	config    configs.Config
+}
+
+// NewNotifier returns the notifier sending mails rendered from templates by mailer.
+// The unsubscribe links point to config.BaseURL, are signed with config.UnsubscribeSecret
+// and expire after config.UnsubscribeLifetime from the time of clk.
+func NewNotifier(db *sql.DB, mailer mail.Mailer, templates *mail.Templates, clk clock.Clock, config configs.Config) *Notifier {
+	return &Notifier{db: db, mailer: mailer, templates: templates, clock: clk, config: config}
+}
+
+// Mail sends the mail of the template name to the user unless the user has opted out of
+// the category of mails. It reports whether the mail is sent. Mails which are not
+// transactional have the unsubscribe link and the List-Unsubscribe headers.
+func (n *Notifier) Mail(ctx context.Context, u *models.User, category Category, name string, data interface{}) (bool, error) {
+	if !u.Email.Valid {
+		return false, nil
+	}
+
+	allowed, err := Allowed(ctx, database.Executor(ctx, n.db), u.ID, category, ChannelEmail)
+
+	if err != nil || !allowed {
+		return false, err
+	}
+
+	tag := translations.Language(ctx)
+
+	var m *mail.Mail
+
+	if category.Transactional() {
+		m, err = n.templates.Render(tag, name, data)
+	} else {
+		expiresAt := n.clock.Now().Add(n.config.UnsubscribeLifetime)
+		m, err = n.templates.RenderUnsubscribable(tag, name, data, UnsubscribeURL(n.config.BaseURL, n.config.UnsubscribeSecret, u.ID, category, expiresAt))
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	m.Recipient = u.Email.String
+
+	if err := n.mailer.Send(ctx, m); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,154 @@
+package notification
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/translations"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrInvalidToken is returned when the unsubscribe token is forged, broken or expired
+var ErrInvalidToken = errors.New("notification: invalid unsubscribe token")
+
+// unsubscribeDomain separates the signatures of the unsubscribe tokens
+// from the other signatures which may be made with the same secret
+const unsubscribeDomain = "unsubscribe:"
+
+// UnsubscribeToken returns the token to unsubscribe the user from the category of mails
+// without signing in until expiresAt. Rotating the secret revokes all the tokens.
+func UnsubscribeToken(secret string, userID int, category Category, expiresAt time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(userID) + ":" + string(category) + ":" + strconv.FormatInt(expiresAt.Unix(), 10)))
+
+	return payload + "." + sign(secret, payload)
+}
+
+// ParseUnsubscribeToken returns the user and the category of the token signed with secret
+// which has not expired at now
+func ParseUnsubscribeToken(secret string, token string, now time.Time) (int, Category, error) {
+	parts := strings.Split(token, ".")
+
+	if len(parts) != 2 || !hmac.Equal([]byte(parts[1]), []byte(sign(secret, parts[0]))) {
+		return 0, "", ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+
+	if err != nil {
+		return 0, "", ErrInvalidToken
+	}
+
+	fields := strings.Split(string(payload), ":")
+
+	if len(fields) != 3 {
+		return 0, "", ErrInvalidToken
+	}
+
+	userID, err := strconv.Atoi(fields[0])
+
+	if err != nil {
+		return 0, "", ErrInvalidToken
+	}
+
+	expiresAt, err := strconv.ParseInt(fields[2], 10, 64)
+
+	if err != nil || !now.Before(time.Unix(expiresAt, 0)) {
+		return 0, "", ErrInvalidToken
+	}
+
+	return userID, Category(fields[1]), nil
+}
+
+func sign(secret string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsubscribeDomain + payload))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// UnsubscribeURL returns the URL of UnsubscribeHandler served at /unsubscribe of baseURL
+func UnsubscribeURL(baseURL string, secret string, userID int, category Category, expiresAt time.Time) string {
+	return baseURL + "/unsubscribe?token=" + url.QueryEscape(UnsubscribeToken(secret, userID, category, expiresAt))
+}
+
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="UTF-8"><meta name="viewport" content="width=device-width, initial-scale=1.0"><title>{{.Title}}</title></head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Message}}</p>
+{{if .Confirm}}<form method="post"><input type="hidden" name="token" value="{{.Token}}"><button type="submit">{{.Button}}</button></form>{{end}}
+</body>
+</html>
+`))
+
+// UnsubscribeHandler disables the email notifications of the category of the token.
+// GET shows the confirmation so that link scanners of mail servers do not unsubscribe users,
+// and POST unsubscribes, which is also sent by mail clients on one-click unsubscribe (RFC 8058).
+// The tokens are verified with secret and expire by the time of clk.
+func UnsubscribeHandler(db *sql.DB, secret string, clk clock.Clock, logger logrus.FieldLogger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx := r.Context()
+		token := r.FormValue("token")
+
+		data := struct {
+			Title   string
+			Message string
+			Button  string
+			Token   string
+			Confirm bool
+		}{
+			Title: translations.T(ctx, "unsubscribe_title"),
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+
+		userID, category, err := ParseUnsubscribeToken(secret, token, clk.Now())
+
+		if err != nil || !category.Valid() || category.Transactional() {
+			data.Message = translations.T(ctx, "invalid_unsubscribe_link")
+			w.WriteHeader(http.StatusBadRequest)
+			unsubscribePage.Execute(w, data)
+			return
+		}
+
+		name := translations.T(ctx, "notification_category_"+string(category))
+
+		if r.Method == http.MethodGet {
+			data.Message = translations.TWithTemplateData(ctx, "unsubscribe_confirm", map[string]interface{}{"Category": name})
+			data.Button = translations.T(ctx, "unsubscribe_button")
+			data.Token = token
+			data.Confirm = true
+			unsubscribePage.Execute(w, data)
+			return
+		}
+
+		err = Set(ctx, db, userID, []Preference{{Category: category, Channel: ChannelEmail, Enabled: false}})
+
+		if err != nil {
+			logger.WithError(err).WithField("user_id", userID).Error("failed to unsubscribe")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		logger.WithFields(logrus.Fields{"user_id": userID, "category": category}).Info("unsubscribed from notifications")
+
+		data.Message = translations.TWithTemplateData(ctx, "unsubscribe_done", map[string]interface{}{"Category": name})
+		unsubscribePage.Execute(w, data)
+	})
+}
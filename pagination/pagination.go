@@ -0,0 +1,269 @@
+// Package pagination implements Relay style cursor connections on top of sqlboiler query mods.
+//
+// Records are paginated by keyset on the order column and `id` so that the query
+// doesn't have to skip rows with OFFSET and stays fast on large tables.
+// The columns are compared without functions so that the index on (column, id) is used.
+//
+//	conn, err := pagination.New(ctx, "User", pagination.Args{First: first, After: after}, pagination.Order{Column: "created_at", Nullable: true})
+//	users, err := models.Users(append(filterMods, conn.QueryMods()...)...).All(ctx, db)
+//	pageInfo := conn.Build(len(users), func(i int) string {
+//		return conn.Cursor(users[i].ID, users[i].CreatedAt)
+//	}, func(i int, cursor string) {
+//		edges = append(edges, models.UserEdge{Cursor: cursor, Node: *users[i]})
+//	})
+package pagination
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+// DefaultLimit is the number of records returned when neither first nor last is given
+const DefaultLimit = 20
+
+const timeFormat = "2006-01-02 15:04:05.999999"
+
+// Args are the Relay connection arguments
+type Args struct {
+	First  *int
+	After  *string
+	Last   *int
+	Before *string
+}
+
+// Order is the column records are sorted by. `id` is always used as the tie breaker.
+// Leave Column empty to sort by `id` only.
+type Order struct {
+	Column string
+	Desc   bool
+	// Nullable must be set for nullable columns, as NULL never matches the comparison
+	// of the cursors and the records would be skipped. NULL is sorted first like MySQL does.
+	Nullable bool
+}
+
+// Connection builds query mods and page info of a page
+type Connection struct {
+	typ      string
+	order    Order
+	limit    int
+	backward bool
+	after    *cursor
+	before   *cursor
+}
+
+type cursor struct {
+	id    int
+	value string
+	// null is true if the value of the nullable column is NULL
+	null bool
+}
+
+// New returns the connection for the arguments.
+// typ is used as the prefix of cursors so that a cursor of another type is rejected.
+func New(ctx context.Context, typ string, args Args, order Order) (*Connection, error) {
+	if args.First != nil && args.Last != nil {
+		return nil, apperror.New(ctx, apperror.CodeValidationFailed, "first_and_last_given")
+	}
+
+	c := &Connection{typ: typ, order: order, limit: DefaultLimit}
+
+	if args.First != nil {
+		c.limit = *args.First
+	}
+
+	if args.Last != nil {
+		c.limit = *args.Last
+		c.backward = true
+	}
+
+	var err error
+
+	if args.After != nil {
+		if c.after, err = c.decode(*args.After); err != nil {
+			return nil, apperror.New(ctx, apperror.CodeValidationFailed, "invalid_cursor")
+		}
+	}
+
+	if args.Before != nil {
+		if c.before, err = c.decode(*args.Before); err != nil {
+			return nil, apperror.New(ctx, apperror.CodeValidationFailed, "invalid_cursor")
+		}
+	}
+
+	return c, nil
+}
+
+// QueryMods returns where, order by and limit clauses of the page.
+// One more record than the limit is fetched to know if there is a next page.
+func (c *Connection) QueryMods() []qm.QueryMod {
+	mods := []qm.QueryMod{}
+
+	if c.after != nil {
+		mods = append(mods, c.where(c.after, !c.order.Desc))
+	}
+
+	if c.before != nil {
+		mods = append(mods, c.where(c.before, c.order.Desc))
+	}
+
+	// walk from the end when paginating backward and reverse them in Build
+	desc := c.order.Desc != c.backward
+	dir := "ASC"
+
+	if desc {
+		dir = "DESC"
+	}
+
+	if c.order.Column != "" {
+		mods = append(mods, qm.OrderBy(fmt.Sprintf("`%s` %s, `id` %s", c.order.Column, dir, dir)))
+	} else {
+		mods = append(mods, qm.OrderBy("`id` "+dir))
+	}
+
+	return append(mods, qm.Limit(c.limit+1))
+}
+
+// Build calls edge for each of n fetched records in the order of the connection
+// with the cursor returned by cursor, and returns the page info.
+func (c *Connection) Build(n int, cursor func(i int) string, edge func(i int, cursor string)) models.PageInfo {
+	more := n > c.limit
+
+	if more {
+		n = c.limit
+	}
+
+	pageInfo := models.PageInfo{}
+
+	if c.backward {
+		pageInfo.HasPreviousPage = more
+		pageInfo.HasNextPage = c.before != nil
+	} else {
+		pageInfo.HasNextPage = more
+		pageInfo.HasPreviousPage = c.after != nil
+	}
+
+	for k := 0; k < n; k++ {
+		i := k
+
+		if c.backward {
+			i = n - 1 - k
+		}
+
+		cur := cursor(i)
+		edge(i, cur)
+
+		if k == 0 {
+			pageInfo.StartCursor = &cur
+		}
+
+		if k == n-1 {
+			pageInfo.EndCursor = &cur
+		}
+	}
+
+	return pageInfo
+}
+
+// Cursor returns the cursor of the record. value is the value of the order column
+// and ignored when the connection is sorted by `id` only. Nullable values like null.Time
+// are encoded without the value when they are NULL.
+func (c *Connection) Cursor(id int, value interface{}) string {
+	s := c.typ + ":" + strconv.Itoa(id)
+
+	if v, ok := value.(driver.Valuer); ok {
+		value, _ = v.Value()
+	}
+
+	if c.order.Column != "" && value != nil {
+		switch v := value.(type) {
+		case time.Time:
+			s += ":" + v.Format(timeFormat)
+		default:
+			s += ":" + fmt.Sprint(v)
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+func (c *Connection) decode(s string) (*cursor, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.SplitN(string(b), ":", 3)
+
+	// the value is missing if it is NULL
+	if parts[0] != c.typ || len(parts) < 2 || (c.order.Column != "" && len(parts) != 3 && !c.order.Nullable) {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	id, err := strconv.Atoi(parts[1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	cur := &cursor{id: id, null: c.order.Column != "" && len(parts) == 2}
+
+	if len(parts) == 3 {
+		cur.value = parts[2]
+	}
+
+	return cur, nil
+}
+
+// where returns the condition of records after the cursor (or before if greater is false)
+func (c *Connection) where(cur *cursor, greater bool) qm.QueryMod {
+	op := "<"
+
+	if greater {
+		op = ">"
+	}
+
+	if c.order.Column == "" {
+		return qm.Where("`id` "+op+" ?", cur.id)
+	}
+
+	col := fmt.Sprintf("`%s`", c.order.Column)
+
+	if !c.order.Nullable {
+		return qm.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND `id` %s ?))", col, op, col, op), cur.value, cur.value, cur.id)
+	}
+
+	// NULL is sorted before any value
+	switch {
+	case cur.null && greater:
+		return qm.Where(fmt.Sprintf("((%s IS NULL AND `id` > ?) OR %s IS NOT NULL)", col, col), cur.id)
+	case cur.null:
+		return qm.Where(fmt.Sprintf("(%s IS NULL AND `id` < ?)", col), cur.id)
+	case greater:
+		return qm.Where(fmt.Sprintf("(%s > ? OR (%s = ? AND `id` > ?))", col, col), cur.value, cur.value, cur.id)
+	default:
+		return qm.Where(fmt.Sprintf("(%s < ? OR (%s = ? AND `id` < ?) OR %s IS NULL)", col, col, col), cur.value, cur.value, cur.id)
+	}
+}
+
+// TotalCountRequested reports whether totalCount of the connection resolved in ctx is selected,
+// so that the records are counted only when it is needed
+func TotalCountRequested(ctx context.Context) bool {
+	for _, f := range graphql.CollectFieldsCtx(ctx, nil) {
+		if f.Name == "totalCount" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,157 @@
+package pagination_test
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/pagination"
+	"github.com/shufo/go-graphql-boilerplate/translations"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/queries"
+	"golang.org/x/text/language"
+)
+
+type PaginationSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (suite *PaginationSuite) SetupTest() {
+	bundle := &i18n.Bundle{DefaultLanguage: language.English}
+	bundle.AddMessages(language.English,
+		&i18n.Message{ID: "invalid_cursor", Other: "invalid cursor"},
+		&i18n.Message{ID: "first_and_last_given", Other: "first and last given"},
+	)
+
+	suite.ctx = context.WithValue(context.Background(), translations.I18nCtxKey, i18n.NewLocalizer(bundle))
+}
+
+// query returns the SQL and the arguments of the page
+func (suite *PaginationSuite) query(conn *pagination.Connection) (string, []interface{}) {
+	return queries.BuildQuery(models.Users(conn.QueryMods()...).Query)
+}
+
+// The cursors encode the type, the id and the value of the order column
+func (suite *PaginationSuite) TestCursor() {
+	createdAt := time.Date(2019, 4, 13, 10, 46, 55, 123000000, time.UTC)
+
+	for _, c := range []struct {
+		name  string
+		order pagination.Order
+		value interface{}
+		want  string
+	}{
+		{name: "id only", value: createdAt, want: "User:1"},
+		{name: "time", order: pagination.Order{Column: "created_at"}, value: createdAt, want: "User:1:2019-04-13 10:46:55.123"},
+		{name: "valid null time", order: pagination.Order{Column: "created_at", Nullable: true}, value: null.TimeFrom(createdAt), want: "User:1:2019-04-13 10:46:55.123"},
+		{name: "null", order: pagination.Order{Column: "created_at", Nullable: true}, value: null.Time{}, want: "User:1"},
+		{name: "string", order: pagination.Order{Column: "email"}, value: "a:b@example.com", want: "User:1:a:b@example.com"},
+	} {
+		conn, err := pagination.New(suite.ctx, "User", pagination.Args{}, c.order)
+		suite.Require().NoError(err, c.name)
+
+		cursor := conn.Cursor(1, c.value)
+		b, err := base64.StdEncoding.DecodeString(cursor)
+		suite.Require().NoError(err, c.name)
+		suite.Equal(c.want, string(b), c.name)
+
+		// the cursor is accepted by the connection of the same order
+		_, err = pagination.New(suite.ctx, "User", pagination.Args{After: &cursor}, c.order)
+		suite.NoError(err, c.name)
+	}
+}
+
+// The cursors are decoded into the conditions of the records after or before them
+func (suite *PaginationSuite) TestDecode() {
+	encode := func(s string) *string {
+		e := base64.StdEncoding.EncodeToString([]byte(s))
+		return &e
+	}
+
+	createdAt := pagination.Order{Column: "created_at"}
+	nullable := pagination.Order{Column: "created_at", Nullable: true}
+
+	for _, c := range []struct {
+		name   string
+		order  pagination.Order
+		args   pagination.Args
+		where  string
+		values []interface{}
+		err    bool
+	}{
+		{name: "id after", args: pagination.Args{After: encode("User:5")}, where: "(`id` > ?)", values: []interface{}{5}},
+		{name: "id before", args: pagination.Args{Before: encode("User:5")}, where: "(`id` < ?)", values: []interface{}{5}},
+		{
+			name: "value after", order: createdAt, args: pagination.Args{After: encode("User:5:2019-04-13 10:46:55")},
+			where:  "((`created_at` > ? OR (`created_at` = ? AND `id` > ?)))",
+			values: []interface{}{"2019-04-13 10:46:55", "2019-04-13 10:46:55", 5},
+		},
+		{
+			name: "value after in desc order", order: pagination.Order{Column: "created_at", Desc: true}, args: pagination.Args{After: encode("User:5:2019-04-13 10:46:55")},
+			where:  "((`created_at` < ? OR (`created_at` = ? AND `id` < ?)))",
+			values: []interface{}{"2019-04-13 10:46:55", "2019-04-13 10:46:55", 5},
+		},
+		{
+			name: "nullable value before", order: nullable, args: pagination.Args{Before: encode("User:5:2019-04-13 10:46:55")},
+			where:  "((`created_at` < ? OR (`created_at` = ? AND `id` < ?) OR `created_at` IS NULL))",
+			values: []interface{}{"2019-04-13 10:46:55", "2019-04-13 10:46:55", 5},
+		},
+		{name: "null after", order: nullable, args: pagination.Args{After: encode("User:5")}, where: "(((`created_at` IS NULL AND `id` > ?) OR `created_at` IS NOT NULL))", values: []interface{}{5}},
+		{name: "null before", order: nullable, args: pagination.Args{Before: encode("User:5")}, where: "((`created_at` IS NULL AND `id` < ?))", values: []interface{}{5}},
+		{name: "other type", args: pagination.Args{After: encode("Role:5")}, err: true},
+		{name: "invalid id", args: pagination.Args{After: encode("User:five")}, err: true},
+		{name: "missing id", args: pagination.Args{After: encode("User")}, err: true},
+		{name: "missing value", order: createdAt, args: pagination.Args{After: encode("User:5")}, err: true},
+		{name: "not base64", args: pagination.Args{After: func() *string { s := "User:5"; return &s }()}, err: true},
+	} {
+		conn, err := pagination.New(suite.ctx, "User", c.args, c.order)
+
+		if c.err {
+			suite.Error(err, c.name)
+			continue
+		}
+
+		suite.Require().NoError(err, c.name)
+
+		sql, args := suite.query(conn)
+		suite.Contains(sql, "WHERE "+c.where+" ORDER BY", c.name)
+		suite.Equal(c.values, args, c.name)
+	}
+}
+
+// One more record than the limit is fetched to know if there is a next page
+func (suite *PaginationSuite) TestBuild() {
+	first := 2
+	conn, err := pagination.New(suite.ctx, "User", pagination.Args{First: &first}, pagination.Order{})
+	suite.Require().NoError(err)
+
+	sql, _ := suite.query(conn)
+	suite.Contains(sql, "ORDER BY `id` ASC LIMIT 3")
+
+	var ids []int
+
+	pageInfo := conn.Build(3, func(i int) string {
+		return conn.Cursor(i+1, nil)
+	}, func(i int, cursor string) {
+		ids = append(ids, i+1)
+	})
+
+	suite.Equal([]int{1, 2}, ids)
+	suite.True(pageInfo.HasNextPage)
+	suite.False(pageInfo.HasPreviousPage)
+	suite.Equal(conn.Cursor(1, nil), *pageInfo.StartCursor)
+	suite.Equal(conn.Cursor(2, nil), *pageInfo.EndCursor)
+
+	// first and last can not be given together
+	_, err = pagination.New(suite.ctx, "User", pagination.Args{First: &first, Last: &first}, pagination.Order{})
+	suite.Error(err)
+}
+
+func TestPaginationSuite(t *testing.T) {
+	suite.Run(t, new(PaginationSuite))
+}
@@ -0,0 +1,91 @@
+package persisted
+
+import (
+	"context"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// redisTTL is how long queries are kept in Redis since they are registered
+const redisTTL = 24 * time.Hour
+
+// Cache stores queries registered by clients
+type Cache interface {
+	Get(ctx context.Context, hash string) (string, bool)
+	Add(ctx context.Context, hash string, query string)
+}
+
+// cache keeps recently used queries in memory in front of Redis shared by servers
+type cache struct {
+	lru  *lru.Cache
+	pool *redis.Pool
+}
+
+// NewCache returns the cache holding size queries in memory.
+// Redis at the address is used as the second level cache unless the address is empty.
+func NewCache(size int, address string) Cache {
+	l, err := lru.New(size)
+
+	if err != nil {
+		panic(err)
+	}
+
+	c := &cache{lru: l}
+
+	if address != "" {
+		c.pool = &redis.Pool{
+			MaxIdle:     10,
+			IdleTimeout: 240 * time.Second,
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial("tcp", address)
+			},
+		}
+	}
+
+	return c
+}
+
+// Get returns the query of the hash
+func (c *cache) Get(ctx context.Context, hash string) (string, bool) {
+	if v, ok := c.lru.Get(hash); ok {
+		return v.(string), true
+	}
+
+	if c.pool == nil {
+		return "", false
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	query, err := redis.String(conn.Do("GET", redisKey(hash)))
+
+	if err != nil {
+		return "", false
+	}
+
+	c.lru.Add(hash, query)
+
+	return query, true
+}
+
+// Add stores the query of the hash.
+// Failure of Redis is ignored as clients will register the query again.
+func (c *cache) Add(ctx context.Context, hash string, query string) {
+	c.lru.Add(hash, query)
+
+	if c.pool == nil {
+		return
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	conn.Do("SET", redisKey(hash), query, "EX", int(redisTTL.Seconds()))
+}
+
+func redisKey(hash string) string {
+	return "apq:" + hash
+}
@@ -0,0 +1,41 @@
+package persisted
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// Manifest maps the hash to the query text of every query clients are allowed to send
+type Manifest map[string]string
+
+// LoadManifest reads the manifest of the JSON object from hash to query,
+// which is the format of persisted query manifest generated by Relay compiler
+// and Apollo tools. It fails if a hash doesn't match its query.
+func LoadManifest(path string) (Manifest, error) {
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := Manifest{}
+
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	for hash, query := range m {
+		if Hash(query) != hash {
+			return nil, fmt.Errorf("hash %s doesn't match its query in %s", hash, path)
+		}
+	}
+
+	return m, nil
+}
+
+// Allows reports whether the query is in the manifest
+func (m Manifest) Allows(query string) bool {
+	_, ok := m[Hash(query)]
+	return ok
+}
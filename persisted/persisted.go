@@ -0,0 +1,252 @@
+// Package persisted implements Apollo compatible automatic persisted queries.
+//
+// Clients send the sha256 hash of the query in `extensions.persistedQuery` instead of
+// the query text. The server answers PersistedQueryNotFound when it doesn't know the
+// hash yet, then the client sends the hash with the query text to register it.
+//
+// In strict mode only queries in the manifest generated at build time of clients are
+// accepted, and registration from clients is disabled.
+package persisted
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/shufo/go-graphql-boilerplate/translations"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+// Error codes returned in extensions. Messages of not found and not supported
+// are defined by Apollo client and must not be translated.
+const (
+	codeNotFound     = "PERSISTED_QUERY_NOT_FOUND"
+	codeNotSupported = "PERSISTED_QUERY_NOT_SUPPORTED"
+	codeHashMismatch = "PERSISTED_QUERY_HASH_MISMATCH"
+	codeNotAllowed   = "PERSISTED_QUERY_NOT_ALLOWED"
+)
+
+type extensions struct {
+	PersistedQuery *struct {
+		Version    int    `json:"version"`
+		Sha256Hash string `json:"sha256Hash"`
+	} `json:"persistedQuery"`
+}
+
+// Hash returns the hash of the query used as the persisted query id
+func Hash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// Middleware resolves the query of the persisted query request before it reaches
+// the GraphQL handler. Queries are looked up from the manifest if it is given
+// (strict mode), otherwise from the cache where clients register them.
+// Websocket requests are passed through. Use Schema to restrict them in strict mode.
+func Middleware(cache Cache, manifest Manifest) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.Contains(r.Header.Get("Upgrade"), "websocket") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var params map[string]json.RawMessage
+
+			switch r.Method {
+			case http.MethodGet:
+				params = map[string]json.RawMessage{}
+
+				for _, k := range []string{"query", "extensions"} {
+					if v := r.URL.Query().Get(k); v != "" {
+						params[k], _ = json.Marshal(v)
+					}
+				}
+			case http.MethodPost:
+				body, err := ioutil.ReadAll(r.Body)
+
+				if err != nil || json.Unmarshal(body, &params) != nil {
+					// let the handler report the invalid body
+					r.Body = ioutil.NopCloser(bytes.NewReader(body))
+					next.ServeHTTP(w, r)
+					return
+				}
+
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			default:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			query, err := resolveQuery(r, params, cache, manifest)
+
+			if err != nil {
+				sendError(w, err)
+				return
+			}
+
+			// the query is already in the request
+			if query == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if r.Method == http.MethodGet {
+				q := r.URL.Query()
+				q.Set("query", query)
+				r.URL.RawQuery = q.Encode()
+			} else {
+				params["query"], _ = json.Marshal(query)
+				body, _ := json.Marshal(params)
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+				r.ContentLength = int64(len(body))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// resolveQuery returns the query to be set in the request.
+// It returns an empty string if the request doesn't need to be changed.
+func resolveQuery(r *http.Request, params map[string]json.RawMessage, cache Cache, manifest Manifest) (string, error) {
+	ctx := r.Context()
+
+	var query string
+	var ext extensions
+
+	if raw, ok := params["query"]; ok {
+		json.Unmarshal(raw, &query)
+	}
+
+	if raw, ok := params["extensions"]; ok {
+		// GET request has extensions as a JSON string
+		var s string
+		if json.Unmarshal(raw, &s) == nil {
+			raw = json.RawMessage(s)
+		}
+
+		json.Unmarshal(raw, &ext)
+	}
+
+	// normal request
+	if ext.PersistedQuery == nil {
+		if manifest != nil && !manifest.Allows(query) {
+			return "", notAllowed(ctx)
+		}
+
+		return "", nil
+	}
+
+	if ext.PersistedQuery.Version != 1 {
+		return "", &gqlerror.Error{
+			Message:    "PersistedQueryNotSupported",
+			Extensions: map[string]interface{}{"code": codeNotSupported},
+		}
+	}
+
+	hash := strings.ToLower(ext.PersistedQuery.Sha256Hash)
+
+	// registration of the query
+	if query != "" {
+		if Hash(query) != hash {
+			return "", &gqlerror.Error{
+				Message:    translations.T(ctx, "persisted_query_hash_mismatch"),
+				Extensions: map[string]interface{}{"code": codeHashMismatch},
+			}
+		}
+
+		if manifest != nil {
+			if !manifest.Allows(query) {
+				return "", notAllowed(ctx)
+			}
+
+			return "", nil
+		}
+
+		cache.Add(ctx, hash, query)
+
+		return "", nil
+	}
+
+	if manifest != nil {
+		q, ok := manifest[hash]
+
+		if !ok {
+			return "", notAllowed(ctx)
+		}
+
+		return q, nil
+	}
+
+	q, ok := cache.Get(ctx, hash)
+
+	if !ok {
+		return "", &gqlerror.Error{
+			Message:    "PersistedQueryNotFound",
+			Extensions: map[string]interface{}{"code": codeNotFound},
+		}
+	}
+
+	return q, nil
+}
+
+// allowlistSchema rejects operations which are not in the manifest
+type allowlistSchema struct {
+	graphql.ExecutableSchema
+	manifest Manifest
+}
+
+// Schema wraps the schema to reject operations not in the manifest. It covers
+// operations over websocket which don't go through Middleware.
+// It returns the schema as it is if the manifest is nil.
+func Schema(es graphql.ExecutableSchema, manifest Manifest) graphql.ExecutableSchema {
+	if manifest == nil {
+		return es
+	}
+
+	return &allowlistSchema{ExecutableSchema: es, manifest: manifest}
+}
+
+func (s *allowlistSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	if !s.manifest.Allows(graphql.GetRequestContext(ctx).RawQuery) {
+		return &graphql.Response{Errors: gqlerror.List{notAllowed(ctx)}}
+	}
+
+	return s.ExecutableSchema.Query(ctx, op)
+}
+
+func (s *allowlistSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	if !s.manifest.Allows(graphql.GetRequestContext(ctx).RawQuery) {
+		return &graphql.Response{Errors: gqlerror.List{notAllowed(ctx)}}
+	}
+
+	return s.ExecutableSchema.Mutation(ctx, op)
+}
+
+func (s *allowlistSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
+	if !s.manifest.Allows(graphql.GetRequestContext(ctx).RawQuery) {
+		return graphql.OneShot(&graphql.Response{Errors: gqlerror.List{notAllowed(ctx)}})
+	}
+
+	return s.ExecutableSchema.Subscription(ctx, op)
+}
+
+func notAllowed(ctx context.Context) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message:    translations.T(ctx, "persisted_query_not_allowed"),
+		Extensions: map[string]interface{}{"code": codeNotAllowed},
+	}
+}
+
+func sendError(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(&graphql.Response{Errors: gqlerror.List{err.(*gqlerror.Error)}})
+}
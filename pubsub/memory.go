@@ -0,0 +1,59 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// Memory delivers messages to subscribers in the same process
+type Memory struct {
+	mu     sync.RWMutex
+	topics map[string]map[chan []byte]struct{}
+}
+
+// NewMemory returns the in-memory PubSub
+func NewMemory() *Memory {
+	return &Memory{topics: map[string]map[chan []byte]struct{}{}}
+}
+
+// Publish sends the message to every subscriber of the topic
+func (m *Memory) Publish(ctx context.Context, topic string, message []byte) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for ch := range m.topics[topic] {
+		select {
+		case ch <- message:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// Subscribe returns the channel receiving messages of the topic until ctx is done
+func (m *Memory) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	ch := make(chan []byte, subscriberBuffer)
+
+	m.mu.Lock()
+	if m.topics[topic] == nil {
+		m.topics[topic] = map[chan []byte]struct{}{}
+	}
+	m.topics[topic][ch] = struct{}{}
+	m.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		m.mu.Lock()
+		delete(m.topics[topic], ch)
+		if len(m.topics[topic]) == 0 {
+			delete(m.topics, topic)
+		}
+		m.mu.Unlock()
+
+		close(ch)
+	}()
+
+	return ch, nil
+}
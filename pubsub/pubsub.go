@@ -0,0 +1,34 @@
+// Package pubsub delivers messages published to a topic to its subscribers.
+// Use the in-memory implementation for a single server and Redis to deliver
+// messages across servers.
+package pubsub
+
+import (
+	"context"
+
+	"github.com/shufo/go-graphql-boilerplate/configs"
+)
+
+// subscriberBuffer is the number of messages buffered for a slow subscriber.
+// Messages are dropped for the subscriber while the buffer is full.
+const subscriberBuffer = 16
+
+// PubSub publishes messages to topics and subscribes them
+type PubSub interface {
+	// Publish sends the message to every subscriber of the topic
+	Publish(ctx context.Context, topic string, message []byte) error
+	// Subscribe returns the channel receiving messages of the topic.
+	// The channel is closed when ctx is done.
+	Subscribe(ctx context.Context, topic string) (<-chan []byte, error)
+}
+
+// New returns the PubSub selected by the driver of the config.
+// "redis" connects to the Redis, otherwise messages are delivered in memory.
+func New(config configs.PubSub, redis configs.Redis) PubSub {
+	switch config.Driver {
+	case "redis":
+		return NewRedis(redis.Address())
+	default:
+		return NewMemory()
+	}
+}
@@ -0,0 +1,80 @@
+package pubsub
+
+import (
+	"context"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// Redis delivers messages through Redis PUBLISH and SUBSCRIBE
+type Redis struct {
+	pool *redis.Pool
+}
+
+// NewRedis returns the PubSub connecting to the Redis server at the address
+func NewRedis(address string) *Redis {
+	return &Redis{
+		pool: &redis.Pool{
+			MaxIdle:     10,
+			IdleTimeout: 240 * time.Second,
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial("tcp", address)
+			},
+		},
+	}
+}
+
+// Publish sends the message to every subscriber of the topic
+func (r *Redis) Publish(ctx context.Context, topic string, message []byte) error {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("PUBLISH", topic, message)
+
+	return err
+}
+
+// Subscribe returns the channel receiving messages of the topic until ctx is done.
+// Each subscription holds its own connection as Redis doesn't allow other commands
+// on the subscribing connection.
+func (r *Redis) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	conn := r.pool.Get()
+	psc := redis.PubSubConn{Conn: conn}
+
+	if err := psc.Subscribe(topic); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	ch := make(chan []byte, subscriberBuffer)
+
+	// unsubscribe to unblock Receive when ctx is done
+	go func() {
+		<-ctx.Done()
+		psc.Unsubscribe()
+	}()
+
+	go func() {
+		defer close(ch)
+		defer conn.Close()
+
+		for {
+			switch v := psc.Receive().(type) {
+			case redis.Message:
+				select {
+				case ch <- v.Data:
+				default:
+				}
+			case redis.Subscription:
+				if v.Count == 0 {
+					return
+				}
+			case error:
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
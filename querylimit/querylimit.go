@@ -0,0 +1,172 @@
+// Package querylimit rejects operations which are too deep or too complex
+// before they are executed.
+package querylimit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/auth"
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+// Limit is the maximum depth and complexity of an operation
+type Limit struct {
+	Depth      int
+	Complexity int
+}
+
+// limitSchema checks limits of the operation before executing it
+type limitSchema struct {
+	graphql.ExecutableSchema
+	config configs.QueryLimit
+}
+
+// Schema wraps the schema to reject operations exceeding the limit of the user.
+// Introspection fields are not counted so that tools like playground keep working.
+func Schema(es graphql.ExecutableSchema, config configs.QueryLimit) graphql.ExecutableSchema {
+	return &limitSchema{ExecutableSchema: es, config: config}
+}
+
+func (s *limitSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	if err := s.check(ctx, op); err != nil {
+		return &graphql.Response{Errors: gqlerror.List{err}}
+	}
+
+	return s.ExecutableSchema.Query(ctx, op)
+}
+
+func (s *limitSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	if err := s.check(ctx, op); err != nil {
+		return &graphql.Response{Errors: gqlerror.List{err}}
+	}
+
+	return s.ExecutableSchema.Mutation(ctx, op)
+}
+
+func (s *limitSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
+	if err := s.check(ctx, op); err != nil {
+		return graphql.OneShot(&graphql.Response{Errors: gqlerror.List{err}})
+	}
+
+	return s.ExecutableSchema.Subscription(ctx, op)
+}
+
+// check returns the error if the operation exceeds the limit
+func (s *limitSchema) check(ctx context.Context, op *ast.OperationDefinition) *gqlerror.Error {
+	limit := s.limitFor(ctx)
+
+	op = withoutIntrospection(op)
+
+	if depth := Depth(op.SelectionSet); limit.Depth > 0 && depth > limit.Depth {
+		return exceeded(ctx, "depth", limit.Depth, depth)
+	}
+
+	vars := graphql.GetRequestContext(ctx).Variables
+
+	if c := complexity.Calculate(s, op, vars); limit.Complexity > 0 && c > limit.Complexity {
+		return exceeded(ctx, "complexity", limit.Complexity, c)
+	}
+
+	return nil
+}
+
+// Complexity looks up the complexity function by the Go name of the field.
+// The generated code of gqlgen v0.8.2 switches on the Go name while the
+// complexity walker passes the name in the schema.
+func (s *limitSchema) Complexity(typeName, field string, childComplexity int, args map[string]interface{}) (int, bool) {
+	return s.ExecutableSchema.Complexity(typeName, goName(field), childComplexity, args)
+}
+
+// limitFor returns the limit of the current user
+func (s *limitSchema) limitFor(ctx context.Context) Limit {
+	if _, ok := auth.UserIDForContext(ctx); !ok {
+		return Limit{Depth: s.config.MaxDepth, Complexity: s.config.MaxComplexity}
+	}
+
+	roles, _ := auth.ForContext(ctx)["roles"].([]interface{})
+
+	for _, role := range roles {
+		if role == models.RoleTypeSuperAdmin.String() {
+			return Limit{Depth: s.config.MaxDepthAdmin, Complexity: s.config.MaxComplexityAdmin}
+		}
+	}
+
+	return Limit{Depth: s.config.MaxDepthAuthenticated, Complexity: s.config.MaxComplexityAuthenticated}
+}
+
+// Depth returns the maximum nesting level of fields in the selection set
+func Depth(selectionSet ast.SelectionSet) int {
+	max := 0
+
+	for _, selection := range selectionSet {
+		var depth int
+
+		switch s := selection.(type) {
+		case *ast.Field:
+			depth = 1 + Depth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			depth = Depth(s.Definition.SelectionSet)
+		case *ast.InlineFragment:
+			depth = Depth(s.SelectionSet)
+		}
+
+		if depth > max {
+			max = depth
+		}
+	}
+
+	return max
+}
+
+// withoutIntrospection returns the copy of the operation without root introspection fields
+func withoutIntrospection(op *ast.OperationDefinition) *ast.OperationDefinition {
+	res := *op
+	res.SelectionSet = ast.SelectionSet{}
+
+	for _, selection := range op.SelectionSet {
+		if f, ok := selection.(*ast.Field); ok && (f.Name == "__schema" || f.Name == "__type") {
+			continue
+		}
+
+		res.SelectionSet = append(res.SelectionSet, selection)
+	}
+
+	return &res
+}
+
+func exceeded(ctx context.Context, kind string, max int, actual int) *gqlerror.Error {
+	err := apperror.NewWithTemplateData(ctx, apperror.CodeQueryLimitExceeded, "query_"+kind+"_exceeded", map[string]interface{}{
+		"Max":    max,
+		"Actual": actual,
+	})
+	err.Extensions["limit"] = kind
+	err.Extensions["max"] = max
+	err.Extensions["actual"] = actual
+
+	return err
+}
+
+// goName converts the field name in the schema into the Go name generated by gqlgen
+func goName(field string) string {
+	if field == "" {
+		return field
+	}
+
+	name := strings.ToUpper(field[:1]) + field[1:]
+
+	switch {
+	case strings.HasSuffix(name, "Id"):
+		name = strings.TrimSuffix(name, "Id") + "ID"
+	case strings.HasSuffix(name, "Ids"):
+		name = strings.TrimSuffix(name, "Ids") + "IDs"
+	}
+
+	return name
+}
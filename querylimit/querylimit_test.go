@@ -0,0 +1,138 @@
+package querylimit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-chi/jwtauth"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/auth"
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/shufo/go-graphql-boilerplate/graph/generated"
+	"github.com/shufo/go-graphql-boilerplate/querylimit"
+	"github.com/shufo/go-graphql-boilerplate/resolver"
+	"github.com/shufo/go-graphql-boilerplate/translations"
+	"github.com/stretchr/testify/suite"
+	"github.com/vektah/gqlparser"
+	"github.com/vektah/gqlparser/ast"
+	"golang.org/x/text/language"
+)
+
+// executedSchema reports the operations passed the limits without executing them
+type executedSchema struct {
+	graphql.ExecutableSchema
+}
+
+func (s executedSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	return &graphql.Response{}
+}
+
+type QueryLimitSuite struct {
+	suite.Suite
+	es     graphql.ExecutableSchema
+	bundle *i18n.Bundle
+}
+
+func (suite *QueryLimitSuite) SetupSuite() {
+	r := &resolver.Resolver{}
+	suite.es = generated.NewExecutableSchema(generated.Config{
+		Resolvers:  r,
+		Directives: resolver.NewDirectives(r),
+		Complexity: resolver.NewComplexity(),
+	})
+
+	suite.bundle = &i18n.Bundle{DefaultLanguage: language.English}
+	suite.bundle.AddMessages(language.English,
+		&i18n.Message{ID: "query_depth_exceeded", Other: "The query depth {{.Actual}} exceeds the maximum depth {{.Max}}"},
+		&i18n.Message{ID: "query_complexity_exceeded", Other: "The query complexity {{.Actual}} exceeds the maximum complexity {{.Max}}"},
+	)
+}
+
+// run checks the query of the user with the claims against the limits
+func (suite *QueryLimitSuite) run(query string, variables map[string]interface{}, claims jwtauth.Claims) *graphql.Response {
+	doc, errs := gqlparser.LoadQuery(suite.es.Schema(), query)
+	suite.Require().Empty(errs, query)
+
+	ctx := context.WithValue(context.Background(), translations.I18nCtxKey, i18n.NewLocalizer(suite.bundle))
+	ctx = context.WithValue(ctx, auth.UserCtxKey, claims)
+	ctx = graphql.WithRequestContext(ctx, graphql.NewRequestContext(doc, query, variables))
+
+	es := querylimit.Schema(executedSchema{suite.es}, configs.QueryLimit{
+		MaxDepth:                   4,
+		MaxComplexity:              200,
+		MaxDepthAuthenticated:      5,
+		MaxComplexityAuthenticated: 1000,
+		MaxDepthAdmin:              6,
+		MaxComplexityAdmin:         5000,
+	})
+
+	return es.Query(ctx, doc.Operations[0])
+}
+
+// Operations over the complexity limit of the user are rejected.
+// The edges of connections are multiplied by the number of requested records.
+func (suite *QueryLimitSuite) TestComplexity() {
+	anonymous := jwtauth.Claims{}
+	user := jwtauth.Claims{"user_id": float64(1), "roles": []interface{}{"USER"}}
+	admin := jwtauth.Claims{"user_id": float64(2), "roles": []interface{}{"SUPER_ADMIN"}}
+
+	// users = 1 + first * (edges 1 + node 1 + databaseId 1)
+	users := `query ($first: Int) { users(first: $first) { edges { node { databaseId } } } }`
+	aliased := `{
+		a: users(first: 100) { edges { node { databaseId } } }
+		b: users(first: 100) { edges { node { databaseId } } }
+		c: users(first: 100) { edges { node { databaseId } } }
+		d: users(first: 100) { edges { node { databaseId } } }
+	}`
+
+	for _, c := range []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+		claims    jwtauth.Claims
+		// actual is the complexity reported when it exceeds the limit, 0 if the query is allowed
+		actual int
+	}{
+		{name: "single field", query: `{ user { databaseId } }`, claims: anonymous},
+		{name: "default page size", query: users, claims: anonymous},
+		{name: "small page", query: users, variables: map[string]interface{}{"first": 10}, claims: anonymous},
+		{name: "large page of anonymous", query: users, variables: map[string]interface{}{"first": 100}, claims: anonymous, actual: 301},
+		{name: "large page of user", query: users, variables: map[string]interface{}{"first": 100}, claims: user},
+		{name: "aliases of user", query: aliased, claims: user, actual: 1204},
+		{name: "aliases of admin", query: aliased, claims: admin},
+		{name: "introspection", query: `{ __schema { types { name fields { name type { name } } } } }`, claims: anonymous},
+	} {
+		res := suite.run(c.query, c.variables, c.claims)
+
+		if c.actual == 0 {
+			suite.Empty(res.Errors, c.name)
+			continue
+		}
+
+		suite.Require().Len(res.Errors, 1, c.name)
+		suite.Equal("complexity", res.Errors[0].Extensions["limit"], c.name)
+		suite.Equal(c.actual, res.Errors[0].Extensions["actual"], c.name)
+		suite.Equal(apperror.CodeQueryLimitExceeded, res.Errors[0].Extensions["code"], c.name)
+	}
+}
+
+// Operations nested deeper than the limit of the user are rejected
+func (suite *QueryLimitSuite) TestDepth() {
+	// users > edges > node > authenticationProviders > databaseId, and fragments are not counted
+	query := `{ users { edges { node { ...providers } } } } fragment providers on User { authenticationProviders { databaseId } }`
+
+	res := suite.run(query, nil, jwtauth.Claims{})
+	suite.Require().Len(res.Errors, 1)
+	suite.Equal("depth", res.Errors[0].Extensions["limit"])
+	suite.Equal(5, res.Errors[0].Extensions["actual"])
+	suite.Equal(4, res.Errors[0].Extensions["max"])
+
+	res = suite.run(query, nil, jwtauth.Claims{"user_id": float64(1)})
+	suite.Empty(res.Errors)
+}
+
+func TestQueryLimitSuite(t *testing.T) {
+	suite.Run(t, new(QueryLimitSuite))
+}
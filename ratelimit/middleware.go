@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/auth"
+	"github.com/sirupsen/logrus"
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+type contextKey struct {
+	name string
+}
+
+var (
+	addressCtxKey = &contextKey{name: "ratelimit_address"}
+	headerCtxKey  = &contextKey{name: "ratelimit_header"}
+)
+
+// Middleware limits the requests of each client by the rule. Clients are identified by
+// the user id if they are authenticated, otherwise by the IP address, so it must be used
+// after auth.Middleware and clientip.Middleware. The requests are allowed if the store fails.
+func Middleware(store Store, rule Rule, logger logrus.FieldLogger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), addressCtxKey, address(r))
+
+			// operations over websocket run concurrently after the headers are sent
+			if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				ctx = context.WithValue(ctx, headerCtxKey, &header{h: w.Header()})
+			}
+
+			subject, _ := SubjectForContext(ctx)
+			res, err := store.Take(ctx, "request:"+subject, rule)
+
+			if err != nil {
+				logger.WithError(err).Error("failed to take rate limit")
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			SetHeadersForContext(ctx, res)
+
+			if !res.Allowed {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				json.NewEncoder(w).Encode(&graphql.Response{Errors: gqlerror.List{Error(ctx)}})
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// address returns the IP address of the client. clientip.Middleware sets it without port.
+func address(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// SubjectForContext returns "user:<id>" of the authenticated user or "ip:<address>"
+// of the client. The second return value is false if Middleware has not run.
+func SubjectForContext(ctx context.Context) (string, bool) {
+	if id, ok := auth.UserIDForContext(ctx); ok {
+		return fmt.Sprintf("user:%d", id), true
+	}
+
+	addr, ok := ctx.Value(addressCtxKey).(string)
+
+	return "ip:" + addr, ok
+}
+
+// header is the headers of the response shared by the fields resolved concurrently
+type header struct {
+	mu sync.Mutex
+	h  http.Header
+}
+
+// SetHeadersForContext sets the headers of the response to the request by SetHeaders.
+// Nothing is set over websocket as the headers are already sent.
+func SetHeadersForContext(ctx context.Context, r Result) {
+	h, ok := ctx.Value(headerCtxKey).(*header)
+
+	if !ok {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	SetHeaders(h.h, r)
+}
+
+// Error returns the error of the request which exceeds the limit
+func Error(ctx context.Context) *gqlerror.Error {
+	return apperror.New(ctx, apperror.CodeRateLimited, "rate_limited")
+}
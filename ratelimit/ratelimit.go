@@ -0,0 +1,89 @@
+// Package ratelimit limits the rate of requests by token buckets kept in Redis or in memory.
+// Each bucket holds up to Limit tokens and is refilled at Limit tokens per Window,
+// so that clients can burst up to Limit requests and then continue at the steady rate.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Rule is the number of requests allowed in the window
+type Rule struct {
+	Limit  int
+	Window time.Duration
+}
+
+// Result is the state of the bucket after taking a token
+type Result struct {
+	// Allowed reports whether the request is allowed
+	Allowed bool
+	Limit   int
+	// Remaining is the number of requests allowed immediately after this one
+	Remaining int
+	// Reset is the time until the bucket is refilled to Limit
+	Reset time.Duration
+	// RetryAfter is the time until the next request is allowed if this one is not
+	RetryAfter time.Duration
+}
+
+// Store takes tokens from the buckets
+type Store interface {
+	// Take takes a token from the bucket of the key for the rule
+	Take(ctx context.Context, key string, rule Rule) (Result, error)
+}
+
+// refill returns the tokens in the bucket which had tokens elapsed ago
+func refill(tokens float64, elapsed time.Duration, rule Rule) float64 {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	return math.Min(float64(rule.Limit), tokens+float64(elapsed)*rate(rule))
+}
+
+// rate is the number of tokens refilled per nanosecond
+func rate(rule Rule) float64 {
+	return float64(rule.Limit) / float64(rule.Window)
+}
+
+// result returns the result of the request which left tokens in the bucket
+func result(tokens float64, allowed bool, rule Rule) Result {
+	r := Result{
+		Allowed:   allowed,
+		Limit:     rule.Limit,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     time.Duration(math.Ceil((float64(rule.Limit) - tokens) / rate(rule))),
+	}
+
+	if !allowed {
+		r.RetryAfter = time.Duration(math.Ceil((1 - tokens) / rate(rule)))
+	}
+
+	return r
+}
+
+// SetHeaders sets RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers,
+// and Retry-After if the request is not allowed. The headers of the most restrictive
+// limit are kept when multiple limits are applied to the request.
+func SetHeaders(h http.Header, r Result) {
+	if current, err := strconv.Atoi(h.Get("RateLimit-Remaining")); err == nil && current < r.Remaining && r.Allowed {
+		return
+	}
+
+	h.Set("RateLimit-Limit", strconv.Itoa(r.Limit))
+	h.Set("RateLimit-Remaining", strconv.Itoa(r.Remaining))
+	h.Set("RateLimit-Reset", strconv.Itoa(seconds(r.Reset)))
+
+	if !r.Allowed {
+		h.Set("Retry-After", strconv.Itoa(seconds(r.RetryAfter)))
+	}
+}
+
+// seconds rounds d up to seconds
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,110 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/ratelimit"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitSuite struct {
+	suite.Suite
+}
+
+// The bucket is refilled at Limit tokens per Window up to Limit
+func (suite *RateLimitSuite) TestRefill() {
+	// a token is refilled per second
+	rule := ratelimit.Rule{Limit: 3, Window: 3 * time.Second}
+
+	type take struct {
+		// elapsed is the time since the previous take
+		elapsed    time.Duration
+		allowed    bool
+		remaining  int
+		retryAfter time.Duration
+	}
+
+	for _, c := range []struct {
+		name  string
+		takes []take
+	}{
+		{
+			name: "burst up to the limit",
+			takes: []take{
+				{allowed: true, remaining: 2},
+				{allowed: true, remaining: 1},
+				{allowed: true, remaining: 0},
+				{allowed: false, remaining: 0, retryAfter: time.Second},
+			},
+		},
+		{
+			name: "refilled at the rate",
+			takes: []take{
+				{allowed: true, remaining: 2},
+				{allowed: true, remaining: 1},
+				{allowed: true, remaining: 0},
+				{elapsed: time.Second, allowed: true, remaining: 0},
+				{elapsed: 500 * time.Millisecond, allowed: false, remaining: 0, retryAfter: 500 * time.Millisecond},
+				{elapsed: 500 * time.Millisecond, allowed: true, remaining: 0},
+			},
+		},
+		{
+			name: "not refilled over the limit",
+			takes: []take{
+				{allowed: true, remaining: 2},
+				{elapsed: time.Hour, allowed: true, remaining: 2},
+			},
+		},
+		{
+			name: "refused requests take no token",
+			takes: []take{
+				{allowed: true, remaining: 2},
+				{allowed: true, remaining: 1},
+				{allowed: true, remaining: 0},
+				{allowed: false, remaining: 0, retryAfter: time.Second},
+				{allowed: false, remaining: 0, retryAfter: time.Second},
+				{elapsed: time.Second, allowed: true, remaining: 0},
+			},
+		},
+	} {
+		clk := clock.NewMock(time.Date(2019, 4, 13, 10, 0, 0, 0, time.UTC))
+		store := ratelimit.NewMemoryStore(clk)
+
+		for i, t := range c.takes {
+			clk.Add(t.elapsed)
+
+			r, err := store.Take(context.Background(), "key", rule)
+			suite.Require().NoError(err, c.name)
+
+			suite.Equal(t.allowed, r.Allowed, "%s: take %d", c.name, i)
+			suite.Equal(t.remaining, r.Remaining, "%s: take %d", c.name, i)
+			suite.Equal(t.retryAfter, r.RetryAfter, "%s: take %d", c.name, i)
+			suite.Equal(rule.Limit, r.Limit, "%s: take %d", c.name, i)
+		}
+	}
+}
+
+// The buckets of the keys are separated
+func (suite *RateLimitSuite) TestKeys() {
+	store := ratelimit.NewMemoryStore(clock.NewMock(time.Now()))
+	rule := ratelimit.Rule{Limit: 1, Window: time.Minute}
+
+	r, err := store.Take(context.Background(), "a", rule)
+	suite.Require().NoError(err)
+	suite.True(r.Allowed)
+
+	r, err = store.Take(context.Background(), "a", rule)
+	suite.Require().NoError(err)
+	suite.False(r.Allowed)
+
+	r, err = store.Take(context.Background(), "b", rule)
+	suite.Require().NoError(err)
+	suite.True(r.Allowed)
+}
+
+func TestRateLimitSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitSuite))
+}
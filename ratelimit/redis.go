@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/shufo/go-graphql-boilerplate/clock"
+)
+
+// takeScript refills and takes a token from the bucket atomically.
+// The time is given by the server so that it can be mocked in tests.
+//
+// KEYS[1] bucket key, ARGV[1] limit, ARGV[2] window in ms, ARGV[3] now in ms
+var takeScript = redis.NewScript(1, `
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local tokens = tonumber(redis.call('HGET', KEYS[1], 'tokens'))
+local updated = tonumber(redis.call('HGET', KEYS[1], 'updated'))
+
+if tokens == nil or updated == nil then
+  tokens = limit
+  updated = now
+end
+
+tokens = math.min(limit, tokens + math.max(0, now - updated) * limit / window)
+
+local allowed = 0
+
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+end
+
+redis.call('HMSET', KEYS[1], 'tokens', tostring(tokens), 'updated', tostring(now))
+redis.call('PEXPIRE', KEYS[1], window)
+
+return {allowed, tostring(tokens)}
+`)
+
+// RedisStore keeps the buckets in Redis shared by servers
+type RedisStore struct {
+	pool  *redis.Pool
+	clock clock.Clock
+}
+
+// NewRedisStore returns the store in Redis at the address
+func NewRedisStore(address string, clk clock.Clock) *RedisStore {
+	return &RedisStore{
+		pool: &redis.Pool{
+			MaxIdle:     10,
+			IdleTimeout: 240 * time.Second,
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial("tcp", address)
+			},
+		},
+		clock: clk,
+	}
+}
+
+// Take takes a token from the bucket of the key
+func (s *RedisStore) Take(ctx context.Context, key string, rule Rule) (Result, error) {
+	conn, err := s.pool.GetContext(ctx)
+
+	if err != nil {
+		return Result{}, err
+	}
+
+	defer conn.Close()
+
+	now := s.clock.Now().UnixNano() / int64(time.Millisecond)
+	window := int64(rule.Window / time.Millisecond)
+
+	values, err := redis.Values(takeScript.Do(conn, "ratelimit:"+key, rule.Limit, window, now))
+
+	if err != nil {
+		return Result{}, err
+	}
+
+	var allowed int
+	var tokens string
+
+	if _, err := redis.Scan(values, &allowed, &tokens); err != nil {
+		return Result{}, err
+	}
+
+	left, err := strconv.ParseFloat(tokens, 64)
+
+	if err != nil {
+		return Result{}, err
+	}
+
+	return result(left, allowed == 1, rule), nil
+}
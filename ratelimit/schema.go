@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/sirupsen/logrus"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+// operationSchema limits the operations of each client by their names
+type operationSchema struct {
+	graphql.ExecutableSchema
+	store  Store
+	rule   Rule
+	logger logrus.FieldLogger
+}
+
+// Schema wraps the schema to limit the operations of each client by the operation name,
+// so that a client repeating one operation doesn't use up the limit of the requests.
+// Operations without names are limited only by Middleware. It must be used inside
+// auth.WebsocketSchema to identify the users over websocket.
+func Schema(es graphql.ExecutableSchema, store Store, rule Rule, logger logrus.FieldLogger) graphql.ExecutableSchema {
+	return &operationSchema{ExecutableSchema: es, store: store, rule: rule, logger: logger}
+}
+
+func (s *operationSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	if err := s.take(ctx, op); err != nil {
+		return &graphql.Response{Errors: gqlerror.List{err}}
+	}
+
+	return s.ExecutableSchema.Query(ctx, op)
+}
+
+func (s *operationSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	if err := s.take(ctx, op); err != nil {
+		return &graphql.Response{Errors: gqlerror.List{err}}
+	}
+
+	return s.ExecutableSchema.Mutation(ctx, op)
+}
+
+func (s *operationSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
+	if err := s.take(ctx, op); err != nil {
+		return graphql.OneShot(&graphql.Response{Errors: gqlerror.List{err}})
+	}
+
+	return s.ExecutableSchema.Subscription(ctx, op)
+}
+
+// take returns the error if the client exceeds the limit of the operation.
+// The operation is allowed if the store fails.
+func (s *operationSchema) take(ctx context.Context, op *ast.OperationDefinition) *gqlerror.Error {
+	subject, ok := SubjectForContext(ctx)
+
+	if !ok || op.Name == "" {
+		return nil
+	}
+
+	res, err := s.store.Take(ctx, fmt.Sprintf("operation:%s:%s", op.Name, subject), s.rule)
+
+	if err != nil {
+		s.logger.WithError(err).Error("failed to take rate limit")
+		return nil
+	}
+
+	SetHeadersForContext(ctx, res)
+
+	if !res.Allowed {
+		return Error(ctx)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/pagination"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+func (r *queryResolver) AuditEvents(ctx context.Context, first *int, after *string, filter *models.AuditEventFilter) (*models.AuditEventConnection, error) {
+	db := r.DB
+
+	conn, err := pagination.New(ctx, "AuditEvent", pagination.Args{First: first, After: after}, pagination.Order{Desc: true})
+
+	if err != nil {
+		return nil, err
+	}
+
+	mods := []qm.QueryMod{}
+
+	if filter != nil {
+		mods = auditEventFilterMods(filter)
+	}
+
+	events, err := models.AuditEvents(append(mods, conn.QueryMods()...)...).All(ctx, db)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &models.AuditEventConnection{
+		Edges: []models.AuditEventEdge{},
+	}
+
+	res.PageInfo = conn.Build(len(events), func(i int) string {
+		return conn.Cursor(events[i].ID, nil)
+	}, func(i int, cursor string) {
+		res.Edges = append(res.Edges, models.AuditEventEdge{Cursor: cursor, Node: *events[i]})
+	})
+
+	return res, nil
+}
+
+func auditEventFilterMods(filter *models.AuditEventFilter) []qm.QueryMod {
+	mods := []qm.QueryMod{}
+
+	if filter.Action != nil {
+		mods = append(mods, models.AuditEventWhere.Action.EQ(*filter.Action))
+	}
+
+	if filter.ActorID != nil {
+		mods = append(mods, qm.Where("actor_id = ?", *filter.ActorID))
+	}
+
+	if filter.TargetType != nil {
+		mods = append(mods, qm.Where("target_type = ?", *filter.TargetType))
+	}
+
+	if filter.TargetID != nil {
+		mods = append(mods, qm.Where("target_id = ?", *filter.TargetID))
+	}
+
+	if filter.From != nil {
+		mods = append(mods, models.AuditEventWhere.CreatedAt.GTE(*filter.From))
+	}
+
+	if filter.To != nil {
+		mods = append(mods, models.AuditEventWhere.CreatedAt.LT(*filter.To))
+	}
+
+	return mods
+}
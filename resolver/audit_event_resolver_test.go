@@ -0,0 +1,201 @@
+package resolver_test
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/machinebox/graphql"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+type AuditEventResolverSuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	client   *graphql.Client
+	fixtures *testfixtures.Context
+}
+
+func (suite *AuditEventResolverSuite) SetupSuite() {
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouter(suite.db)
+	suite.ts = httptest.NewServer(m)
+	suite.client = graphql.NewClient(suite.ts.URL + "/query")
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *AuditEventResolverSuite) TearDownSuite() {
+	suite.db.Close()
+}
+
+func (suite *AuditEventResolverSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (suite *AuditEventResolverSuite) authenticate(email string, password string) string {
+	req := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "` + email + `", password: "` + password + `"}) {
+				token
+			}
+		}
+	`)
+
+	var res map[string]map[string]interface{}
+	suite.client.Run(context.Background(), req, &res)
+
+	if res["authUser"] == nil {
+		return ""
+	}
+
+	return res["authUser"]["token"].(string)
+}
+
+// Security relevant events are recorded
+func (suite *AuditEventResolverSuite) TestRecordLogin() {
+	ctx := context.Background()
+
+	suite.authenticate("success@simulator.amazonses.com", "123456")
+	suite.authenticate("success@simulator.amazonses.com", "12345678")
+
+	succeeded, err := models.AuditEvents(models.AuditEventWhere.Action.EQ("login_succeeded")).One(ctx, suite.db)
+	suite.NoError(err)
+	suite.Equal(1, succeeded.ActorID.Int)
+	suite.Equal(1, succeeded.TargetID.Int)
+	suite.NotEmpty(succeeded.IPAddress.String)
+
+	failed, err := models.AuditEvents(models.AuditEventWhere.Action.EQ("login_failed")).One(ctx, suite.db)
+	suite.NoError(err)
+	suite.False(failed.ActorID.Valid)
+	suite.Equal(1, failed.TargetID.Int)
+
+	// the attempted email is recorded for the accounts which don't exist
+	suite.authenticate("unknown@example.com", "123456")
+
+	unknown, err := models.AuditEvents(
+		models.AuditEventWhere.Action.EQ("login_failed"),
+		models.AuditEventWhere.TargetID.IsNull(),
+	).One(ctx, suite.db)
+	suite.NoError(err)
+	suite.Equal("user", unknown.TargetType.String)
+	suite.JSONEq(`{"email": {"before": null, "after": "unknown@example.com"}}`, string(unknown.Diff.JSON))
+}
+
+// The recorded IP address is taken from the proxy headers only behind the trusted proxies
+func (suite *AuditEventResolverSuite) TestRecordClientIP() {
+	ctx := context.Background()
+
+	login := func(client *graphql.Client) string {
+		req := graphql.NewRequest(`
+			mutation authUser {
+				authUser(input: {email: "success@simulator.amazonses.com", password: "123456"}) {
+					token
+				}
+			}
+		`)
+		req.Header.Set("X-Forwarded-For", "203.0.113.1")
+
+		var res map[string]interface{}
+		suite.Require().NoError(client.Run(ctx, req, &res))
+
+		e, err := models.AuditEvents(
+			models.AuditEventWhere.Action.EQ("login_succeeded"),
+			qm.OrderBy("id desc"),
+		).One(ctx, suite.db)
+		suite.Require().NoError(err)
+
+		return e.IPAddress.String
+	}
+
+	// the header forged by the client is ignored
+	suite.NotEqual("203.0.113.1", login(suite.client))
+
+	// the test server is the proxy sending the address of the client
+	os.Setenv("TRUSTED_PROXIES", "127.0.0.1,::1")
+	ts := httptest.NewServer(testutils.PrepareRouter(suite.db))
+	os.Unsetenv("TRUSTED_PROXIES")
+	defer ts.Close()
+
+	suite.Equal("203.0.113.1", login(graphql.NewClient(ts.URL+"/query")))
+}
+
+// Only super admin can query audit events
+func (suite *AuditEventResolverSuite) TestAuditEvents() {
+	ctx := context.Background()
+
+	userToken := suite.authenticate("success@simulator.amazonses.com", "123456")
+	adminToken := suite.authenticate("admin@example.com", "123456")
+
+	req := graphql.NewRequest(`
+		query auditEvents($after: String) {
+			auditEvents(first: 1, after: $after, filter: {action: "login_succeeded"}) {
+				edges {
+					cursor
+					node {
+						action
+						actorId
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`)
+
+	// normal user is not permitted
+	req.Header.Add("Authorization", "Bearer "+userToken)
+
+	var res map[string]map[string]interface{}
+	err := suite.client.Run(ctx, req, &res)
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "not granted")
+
+	// super admin can page through the events from the newest
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+
+	err = suite.client.Run(ctx, req, &res)
+	suite.NoError(err)
+
+	edges := res["auditEvents"]["edges"].([]interface{})
+	pageInfo := res["auditEvents"]["pageInfo"].(map[string]interface{})
+
+	suite.Len(edges, 1)
+	suite.Equal(float64(2), edges[0].(map[string]interface{})["node"].(map[string]interface{})["actorId"])
+	suite.True(pageInfo["hasNextPage"].(bool))
+
+	req.Var("after", pageInfo["endCursor"])
+
+	err = suite.client.Run(ctx, req, &res)
+	suite.NoError(err)
+
+	edges = res["auditEvents"]["edges"].([]interface{})
+	pageInfo = res["auditEvents"]["pageInfo"].(map[string]interface{})
+
+	suite.Len(edges, 1)
+	suite.Equal(float64(1), edges[0].(map[string]interface{})["node"].(map[string]interface{})["actorId"])
+	suite.False(pageInfo["hasNextPage"].(bool))
+}
+
+func TestAuditEventResolverSuite(t *testing.T) {
+	suite.Run(t, new(AuditEventResolverSuite))
+}
@@ -0,0 +1,116 @@
+package resolver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/auth"
+	"github.com/shufo/go-graphql-boilerplate/avatar"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/upload"
+	"github.com/shufo/go-graphql-boilerplate/utils"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// avatarMaxSize is the maximum size of avatar image file in bytes
+const avatarMaxSize = 5 << 20
+
+// avatarPixels is the width and height of each avatar size
+var avatarPixels = map[models.AvatarSize]int{
+	models.AvatarSizeSmall:  64,
+	models.AvatarSizeMedium: 256,
+	models.AvatarSizeLarge:  512,
+}
+
+func (r *mutationResolver) UploadAvatar(ctx context.Context, id upload.ID) (*models.User, error) {
+	db := r.DB
+
+	userID, ok := auth.UserIDForContext(ctx)
+
+	if !ok {
+		return nil, apperror.Unauthenticated(ctx)
+	}
+
+	// only the files of this request can be used
+	file, ok := upload.FileForContext(ctx, id)
+
+	if !ok {
+		return nil, apperror.New(ctx, apperror.CodeValidationFailed, "file_not_uploaded")
+	}
+
+	if file.Size > avatarMaxSize {
+		return nil, apperror.NewWithTemplateData(ctx, apperror.CodeValidationFailed, "upload_too_large", map[string]interface{}{"Max": avatarMaxSize})
+	}
+
+	sizes := []int{}
+
+	for _, px := range avatarPixels {
+		sizes = append(sizes, px)
+	}
+
+	thumbnails, err := avatar.Resize(file.File, sizes)
+
+	switch err {
+	case nil:
+	case avatar.ErrUnsupportedImage:
+		return nil, apperror.New(ctx, apperror.CodeValidationFailed, "unsupported_image")
+	case avatar.ErrImageTooLarge:
+		return nil, apperror.New(ctx, apperror.CodeValidationFailed, "image_dimensions_too_large")
+	default:
+		return nil, err
+	}
+
+	u, err := models.FindUser(ctx, db, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// images are stored under a new key so that cached old images are not served
+	key := fmt.Sprintf("avatars/%d/%s", userID, utils.RandomUUID())
+
+	for px, b := range thumbnails {
+		if err := r.Storage.Put(ctx, avatarKey(key, px), bytes.NewReader(b), avatar.ContentType); err != nil {
+			return nil, err
+		}
+	}
+
+	old := u.AvatarKey
+	u.AvatarKey = null.StringFrom(key)
+
+	if _, err := u.Update(ctx, db, boil.Whitelist(models.UserColumns.AvatarKey)); err != nil {
+		return nil, err
+	}
+
+	if old.Valid {
+		if err := r.Storage.Delete(ctx, old.String+"/"); err != nil {
+			return nil, err
+		}
+	}
+
+	return u, nil
+}
+
+func (r *userResolver) AvatarURL(ctx context.Context, u *models.User, size *models.AvatarSize) (*string, error) {
+	if !u.AvatarKey.Valid {
+		return nil, nil
+	}
+
+	s := models.AvatarSizeMedium
+
+	if size != nil {
+		s = *size
+	}
+
+	url := r.Storage.URL(avatarKey(u.AvatarKey.String, avatarPixels[s]))
+
+	return &url, nil
+}
+
+// avatarKey returns the key of the avatar image in the size
+func avatarKey(key string, px int) string {
+	return fmt.Sprintf("%s/%d.jpg", key, px)
+}
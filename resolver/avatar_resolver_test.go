@@ -0,0 +1,208 @@
+package resolver_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/machinebox/graphql"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type AvatarResolverSuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	client   *graphql.Client
+	fixtures *testfixtures.Context
+	dir      string
+}
+
+func (suite *AvatarResolverSuite) SetupSuite() {
+	// store uploaded files in temporary directory
+	dir, err := ioutil.TempDir("", "storage")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.dir = dir
+	os.Setenv("STORAGE_LOCAL_DIR", dir)
+
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouter(suite.db)
+	suite.ts = httptest.NewServer(m)
+	suite.client = graphql.NewClient(suite.ts.URL + "/query")
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *AvatarResolverSuite) TearDownSuite() {
+	os.Unsetenv("STORAGE_LOCAL_DIR")
+	os.RemoveAll(suite.dir)
+	suite.db.Close()
+}
+
+func (suite *AvatarResolverSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// upload sends the file by multipart request and returns the response
+func (suite *AvatarResolverSuite) upload(token string, file []byte) map[string]interface{} {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	w.WriteField("operations", `{
+		"query": "mutation uploadAvatar($file: Upload!) { uploadAvatar(file: $file) { databaseId small: avatarUrl(size: SMALL) avatarUrl } }",
+		"variables": {"file": null}
+	}`)
+	w.WriteField("map", `{"0": ["variables.file"]}`)
+
+	part, _ := w.CreateFormFile("0", "avatar.png")
+	part.Write(file)
+	w.Close()
+
+	req, _ := http.NewRequest("POST", suite.ts.URL+"/query", &body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+	defer res.Body.Close()
+
+	var payload map[string]interface{}
+	suite.Require().NoError(json.NewDecoder(res.Body).Decode(&payload))
+
+	return payload
+}
+
+func (suite *AvatarResolverSuite) authenticate() string {
+	req := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "success@simulator.amazonses.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	var res map[string]map[string]interface{}
+	suite.Require().NoError(suite.client.Run(context.Background(), req, &res))
+
+	return res["authUser"]["token"].(string)
+}
+
+func errorCode(payload map[string]interface{}) interface{} {
+	errs, ok := payload["errors"].([]interface{})
+
+	if !ok || len(errs) == 0 {
+		return nil
+	}
+
+	return errs[0].(map[string]interface{})["extensions"].(map[string]interface{})["code"]
+}
+
+// Uploaded image is resized into thumbnails
+func (suite *AvatarResolverSuite) TestUploadAvatar() {
+	var img bytes.Buffer
+	png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 300, 200)))
+
+	token := suite.authenticate()
+	res := suite.upload(token, img.Bytes())
+
+	suite.Nil(res["errors"])
+
+	user := res["data"].(map[string]interface{})["uploadAvatar"].(map[string]interface{})
+	suite.Equal(float64(1), user["databaseId"])
+	suite.Regexp(regexp.MustCompile(`^/storage/avatars/1/[0-9a-f-]+/64\.jpg$`), user["small"])
+	suite.Regexp(regexp.MustCompile(`^/storage/avatars/1/[0-9a-f-]+/256\.jpg$`), user["avatarUrl"])
+
+	// thumbnail is served
+	thumbnail, err := http.Get(suite.ts.URL + user["small"].(string))
+	suite.Require().NoError(err)
+	defer thumbnail.Body.Close()
+
+	suite.Equal(http.StatusOK, thumbnail.StatusCode)
+
+	config, err := jpeg.DecodeConfig(thumbnail.Body)
+	suite.NoError(err)
+	suite.Equal(64, config.Width)
+	suite.Equal(64, config.Height)
+
+	// directories are not listed
+	for _, dir := range []string{"/storage/", "/storage/avatars/1/"} {
+		listing, err := http.Get(suite.ts.URL + dir)
+		suite.Require().NoError(err)
+		listing.Body.Close()
+
+		suite.Equal(http.StatusNotFound, listing.StatusCode, dir)
+	}
+
+	// previous images are removed by the next upload
+	res = suite.upload(token, img.Bytes())
+	suite.Nil(res["errors"])
+
+	previous, err := http.Get(suite.ts.URL + user["small"].(string))
+	suite.Require().NoError(err)
+	previous.Body.Close()
+
+	suite.Equal(http.StatusNotFound, previous.StatusCode)
+}
+
+// Files which are not images are rejected
+func (suite *AvatarResolverSuite) TestInvalidAvatar() {
+	token := suite.authenticate()
+
+	// file name and content type are ignored
+	res := suite.upload(token, []byte("<?php echo 'hello'; ?>"))
+	suite.Equal("VALIDATION_FAILED", errorCode(res))
+
+	// anonymous user
+	var img bytes.Buffer
+	png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 10, 10)))
+
+	res = suite.upload("", img.Bytes())
+	suite.Equal("UNAUTHENTICATED", errorCode(res))
+
+	// Upload can't be given without multipart request
+	req := graphql.NewRequest(`
+		mutation uploadAvatar($file: Upload!) {
+			uploadAvatar(file: $file) {
+				databaseId
+			}
+		}
+	`)
+	req.Var("file", "../../etc/passwd")
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	var data map[string]interface{}
+	err := suite.client.Run(context.Background(), req, &data)
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "The file is not uploaded by the multipart request")
+}
+
+func TestAvatarResolverSuite(t *testing.T) {
+	suite.Run(t, new(AvatarResolverSuite))
+}
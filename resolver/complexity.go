@@ -0,0 +1,65 @@
+package resolver
+
+import (
+	"github.com/shufo/go-graphql-boilerplate/graph/generated"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/pagination"
+)
+
+// NewComplexity returns the complexity of fields which cost more than their children.
+// Fields without a function cost 1 plus the complexity of their children.
+func NewComplexity() generated.ComplexityRoot {
+	c := generated.ComplexityRoot{}
+
+	c.Query.Users = func(childComplexity int, first *int, after *string, last *int, before *string, filter *models.UserFilter, orderBy *models.UserOrder) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	c.Query.AuditEvents = func(childComplexity int, first *int, after *string, filter *models.AuditEventFilter) int {
+		return connectionComplexity(childComplexity, first, nil)
+	}
+
+	c.Query.MailMessages = func(childComplexity int, first *int, after *string, status *models.MailMessageStatus) int {
+		return connectionComplexity(childComplexity, first, nil)
+	}
+
+	c.Query.Nodes = func(childComplexity int, ids []string) int {
+		return 1 + multiply(childComplexity, len(ids))
+	}
+
+	// a user rarely has more than a few authentication providers
+	c.User.AuthenticationProviders = func(childComplexity int) int {
+		return 1 + multiply(childComplexity, 5)
+	}
+
+	return c
+}
+
+// connectionComplexity multiplies the complexity of edges by the number of requested records
+func connectionComplexity(childComplexity int, first *int, last *int) int {
+	n := pagination.DefaultLimit
+
+	if first != nil {
+		n = *first
+	} else if last != nil {
+		n = *last
+	}
+
+	return 1 + multiply(childComplexity, n)
+}
+
+// multiply returns a * b saturated instead of overflowing so that a huge argument
+// can't make the complexity small. Negative operands are treated as 0.
+func multiply(a int, b int) int {
+	const maxComplexity = int(^uint(0)>>1) - 1
+
+	if a <= 0 || b <= 0 {
+		return 0
+	}
+
+	if a > maxComplexity/b {
+		return maxComplexity
+	}
+
+	return a * b
+}
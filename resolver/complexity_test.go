@@ -0,0 +1,188 @@
+package resolver_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/machinebox/graphql"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type QueryLimitSuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	client   *graphql.Client
+	fixtures *testfixtures.Context
+}
+
+func (suite *QueryLimitSuite) SetupSuite() {
+	// small limits to test with the fixtures
+	os.Setenv("QUERY_MAX_DEPTH", "4")
+	os.Setenv("QUERY_MAX_COMPLEXITY", "50")
+	os.Setenv("QUERY_MAX_COMPLEXITY_AUTHENTICATED", "500")
+
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouter(suite.db)
+	suite.ts = httptest.NewServer(m)
+	suite.client = graphql.NewClient(suite.ts.URL + "/query")
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *QueryLimitSuite) TearDownSuite() {
+	os.Unsetenv("QUERY_MAX_DEPTH")
+	os.Unsetenv("QUERY_MAX_COMPLEXITY")
+	os.Unsetenv("QUERY_MAX_COMPLEXITY_AUTHENTICATED")
+	suite.db.Close()
+}
+
+func (suite *QueryLimitSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// post sends the query and returns the errors in the response
+func (suite *QueryLimitSuite) post(query string, token string) []map[string]interface{} {
+	body, _ := json.Marshal(map[string]interface{}{"query": query})
+	req, _ := http.NewRequest("POST", suite.ts.URL+"/query", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+	defer res.Body.Close()
+
+	var payload struct {
+		Errors []map[string]interface{} `json:"errors"`
+	}
+	suite.Require().NoError(json.NewDecoder(res.Body).Decode(&payload))
+
+	return payload.Errors
+}
+
+// Too deep query is rejected
+func (suite *QueryLimitSuite) TestDepthLimit() {
+	errs := suite.post(`
+		query {
+			users {
+				edges {
+					node {
+						authenticationProviders {
+							id
+						}
+					}
+				}
+			}
+		}
+	`, "")
+
+	suite.Len(errs, 1)
+	suite.Contains(errs[0]["message"], "exceeds the maximum depth 4")
+	suite.Equal(map[string]interface{}{
+		"code":   "QUERY_LIMIT_EXCEEDED",
+		"limit":  "depth",
+		"max":    float64(4),
+		"actual": float64(5),
+	}, errs[0]["extensions"])
+}
+
+// Connection multiplies the complexity by the number of requested records
+func (suite *QueryLimitSuite) TestComplexityLimit() {
+	query := `
+		query {
+			users(first: 30) {
+				edges {
+					node {
+						databaseId
+					}
+				}
+			}
+		}
+	`
+
+	errs := suite.post(query, "")
+
+	suite.Len(errs, 1)
+	suite.Contains(errs[0]["message"], "exceeds the maximum complexity 50")
+	suite.Equal("complexity", errs[0]["extensions"].(map[string]interface{})["limit"])
+
+	errs = suite.post(`
+		query {
+			mailMessages(first: 30) {
+				edges {
+					node {
+						subject
+					}
+				}
+			}
+		}
+	`, "")
+
+	suite.Len(errs, 1)
+	suite.Contains(errs[0]["message"], "exceeds the maximum complexity 50")
+
+	// authenticated user has a higher limit
+	authReq := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "success@simulator.amazonses.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	var authResponse map[string]map[string]interface{}
+	suite.NoError(suite.client.Run(context.Background(), authReq, &authResponse))
+
+	errs = suite.post(query, authResponse["authUser"]["token"].(string))
+
+	// passes the limit but the user is not admin
+	suite.Len(errs, 1)
+	suite.Contains(errs[0]["message"], "not granted")
+}
+
+// Introspection is not limited
+func (suite *QueryLimitSuite) TestIntrospection() {
+	errs := suite.post(`
+		query {
+			__schema {
+				types {
+					fields {
+						type {
+							ofType {
+								ofType {
+									name
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	`, "")
+
+	suite.Empty(errs)
+}
+
+func TestQueryLimitSuite(t *testing.T) {
+	suite.Run(t, new(QueryLimitSuite))
+}
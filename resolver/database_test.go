@@ -0,0 +1,117 @@
+package resolver_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+	"github.com/shufo/go-graphql-boilerplate/database"
+	"github.com/stretchr/testify/suite"
+)
+
+// txCounter is the driver counting the transactions committed and rolled back
+type txCounter struct {
+	commits   int
+	rollbacks int
+}
+
+func (d *txCounter) Open(name string) (driver.Conn, error) {
+	return &txCounterConn{d}, nil
+}
+
+type txCounterConn struct {
+	d *txCounter
+}
+
+func (c *txCounterConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func (c *txCounterConn) Close() error {
+	return nil
+}
+
+func (c *txCounterConn) Begin() (driver.Tx, error) {
+	return &txCounterTx{c.d}, nil
+}
+
+type txCounterTx struct {
+	d *txCounter
+}
+
+func (t *txCounterTx) Commit() error {
+	t.d.commits++
+	return nil
+}
+
+func (t *txCounterTx) Rollback() error {
+	t.d.rollbacks++
+	return nil
+}
+
+type DatabaseSuite struct {
+	suite.Suite
+	counter *txCounter
+	db      *sql.DB
+}
+
+func (suite *DatabaseSuite) SetupSuite() {
+	suite.counter = &txCounter{}
+	sql.Register("txcounter", suite.counter)
+}
+
+func (suite *DatabaseSuite) SetupTest() {
+	*suite.counter = txCounter{}
+
+	db, err := sql.Open("txcounter", "")
+	suite.Require().NoError(err)
+	suite.db = db
+}
+
+func (suite *DatabaseSuite) TearDownTest() {
+	suite.db.Close()
+}
+
+// Deadlocks wrapped by sqlboiler are rolled back and retried
+func (suite *DatabaseSuite) TestRetryWrappedDeadlock() {
+	attempts := 0
+
+	err := database.Transaction(context.Background(), suite.db, func(ctx context.Context, tx *sql.Tx) error {
+		attempts++
+
+		if attempts == 1 {
+			return errors.Wrap(&mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock"}, "models: unable to insert into users")
+		}
+
+		return nil
+	})
+
+	suite.NoError(err)
+	suite.Equal(2, attempts)
+	suite.Equal(1, suite.counter.rollbacks)
+	suite.Equal(1, suite.counter.commits)
+}
+
+// Other errors are returned without retrying
+func (suite *DatabaseSuite) TestNotRetryable() {
+	attempts := 0
+	cause := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}
+
+	err := database.Transaction(context.Background(), suite.db, func(ctx context.Context, tx *sql.Tx) error {
+		attempts++
+		return errors.Wrap(cause, "models: unable to insert into users")
+	})
+
+	suite.Equal(cause, errors.Cause(err))
+	suite.Equal(1, attempts)
+	suite.Equal(1, suite.counter.rollbacks)
+	suite.Equal(0, suite.counter.commits)
+}
+
+func TestDatabaseSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseSuite))
+}
@@ -3,52 +3,173 @@ package resolver
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/auth"
 	"github.com/shufo/go-graphql-boilerplate/graph/generated"
+	"github.com/shufo/go-graphql-boilerplate/ratelimit"
 
 	"github.com/99designs/gqlgen/graphql"
-	"github.com/shufo/go-graphql-boilerplate/models"
 	"github.com/go-chi/jwtauth"
+	"github.com/shufo/go-graphql-boilerplate/models"
 )
 
-func NewDirectives() generated.DirectiveRoot {
+// NewDirectives returns the directives. Directives which read the database or
+// the policies use the dependencies of r.
+func NewDirectives(r *Resolver) generated.DirectiveRoot {
 	return generated.DirectiveRoot{
 		HasRole:         HasRole,
-		HasMinimumRole:  HasMinimumRole,
+		HasMinimumRole:  r.HasMinimumRole,
 		IsResourceOwner: IsResourceOwner,
 		Length:          Length,
+		Pattern:         Pattern,
+		Email:           Email,
+		Range:           Range,
+		PhoneNumber:     PhoneNumber,
+		CountryCode:     CountryCode,
+		Can:             r.Can,
+		RateLimit:       r.RateLimit,
 	}
 }
 
-type Roles struct {
-	ADMIN int
-	USER  int
+// HasMinimumRole allows access only if the user holds a role whose rank is equal to
+// or higher than the rank of the required role. Ranks are defined in roles table.
+// If organizationArg is given, the field argument of that name is used as the
+// organization id and roles granted within the organization are also taken into account.
+func (r *Resolver) HasMinimumRole(ctx context.Context, obj interface{}, next graphql.Resolver, role models.RoleType, organizationArg *string) (interface{}, error) {
+	db := r.DB
+
+	userID, ok := auth.UserIDForContext(ctx)
+
+	if !ok {
+		return nil, apperror.Unauthenticated(ctx)
+	}
+
+	var organizationID *int
+
+	if organizationArg != nil {
+		id, ok := intArgument(graphql.GetResolverContext(ctx).Args[*organizationArg])
+
+		if !ok {
+			return nil, apperror.Forbidden(ctx)
+		}
+
+		organizationID = &id
+	}
+
+	required, err := models.RoleRank(ctx, db, role)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if user has permission with required role
+	rank, err := models.UserMaxRoleRank(ctx, db, userID, organizationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if rank < required {
+		return nil, apperror.Forbidden(ctx)
+	}
+
+	return next(ctx)
 }
 
-type RoleMap map[models.RoleType]int
+// Can allows access to the field only if casbin enforcer grants the action on the resource
+// to one of the subjects of the current user. If it is denied, the field resolves to null
+// with a field error instead of failing the whole query.
+func (r *Resolver) Can(ctx context.Context, obj interface{}, next graphql.Resolver, action string, resource string) (interface{}, error) {
+	for _, sub := range subjects(ctx, obj) {
+		if r.Enforcer.Enforce(sub, resource, action) {
+			return next(ctx)
+		}
+	}
+
+	graphql.AddError(ctx, apperror.Forbidden(ctx))
 
-var roles = RoleMap{
-	models.RoleTypeSuperAdmin:         50,
-	models.RoleTypeOrganizationAdmin:  40,
-	models.RoleTypeOrganizationMember: 30,
-	models.RoleTypeResourceOwner:      20,
-	models.RoleTypeUser:               10,
+	return nil, nil
 }
 
-func HasMinimumRole(ctx context.Context, obj interface{}, next graphql.Resolver, role models.RoleType) (interface{}, error) {
-	_, claims, _ := jwtauth.FromContext(ctx)
+// RateLimit allows each client limit requests to the field in window seconds.
+// The limit of the field is kept apart from the limit of the requests by ratelimit.Middleware.
+// The request is allowed if the store fails, not to lock users out while it is down.
+func (r *Resolver) RateLimit(ctx context.Context, obj interface{}, next graphql.Resolver, limit int, window int) (interface{}, error) {
+	subject, ok := ratelimit.SubjectForContext(ctx)
 
-	// Check if user has permission with required role
-	if roles[models.RoleType(claims["role"].(string))] < roles[role] {
-		return nil, fmt.Errorf("You are not granted to access this resource with your role")
+	if !ok {
+		return next(ctx)
+	}
+
+	rc := graphql.GetResolverContext(ctx)
+	key := fmt.Sprintf("field:%s.%s:%s", rc.Object, rc.Field.Name, subject)
+	rule := ratelimit.Rule{Limit: limit, Window: time.Duration(window) * time.Second}
+
+	res, err := r.RateLimiter.Take(ctx, key, rule)
+
+	if err != nil {
+		r.Logger.WithError(err).Error("failed to take rate limit")
+		return next(ctx)
+	}
+
+	ratelimit.SetHeadersForContext(ctx, res)
+
+	if !res.Allowed {
+		return nil, ratelimit.Error(ctx)
 	}
 
 	return next(ctx)
 }
 
+// subjects returns casbin subjects of the current user.
+// The user is represented by "user:<id>", the roles in the claims
+// and RESOURCE_OWNER if the user owns the object.
+func subjects(ctx context.Context, obj interface{}) []string {
+	userID, ok := auth.UserIDForContext(ctx)
+
+	if !ok {
+		return []string{"anonymous"}
+	}
+
+	subs := []string{fmt.Sprintf("user:%d", userID)}
+
+	if roles, ok := auth.ForContext(ctx)["roles"].([]interface{}); ok {
+		for _, v := range roles {
+			if role, ok := v.(string); ok {
+				subs = append(subs, role)
+			}
+		}
+	}
+
+	if ownable, isOwnable := obj.(models.Ownable); isOwnable && *ownable.OwnerID() == userID {
+		subs = append(subs, models.RoleTypeResourceOwner.String())
+	}
+
+	return subs
+}
+
+// intArgument converts the resolved field argument into int
+func intArgument(v interface{}) (int, bool) {
+	switch i := v.(type) {
+	case int:
+		return i, true
+	case *int:
+		if i == nil {
+			return 0, false
+		}
+		return *i, true
+	case int64:
+		return int(i), true
+	}
+
+	return 0, false
+}
+
 func isAuthenticated(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
 	if _, _, err := jwtauth.FromContext(ctx); err != nil {
-		return nil, err
+		return nil, apperror.Unauthenticated(ctx)
 	}
 
 	return next(ctx)
@@ -60,32 +181,32 @@ func HasRole(ctx context.Context, obj interface{}, next graphql.Resolver, role m
 	case models.RoleTypeOrganizationAdmin:
 		_, claims, err := jwtauth.FromContext(ctx)
 		if err != nil {
-			return nil, err
+			return nil, apperror.Unauthenticated(ctx)
 		}
 
 		if claims["role"] != nil && claims["role"] != models.RoleTypeOrganizationAdmin.String() {
-			return nil, fmt.Errorf("You are not granted access this resource")
+			return nil, apperror.Forbidden(ctx)
 		}
 
 	case models.RoleTypeResourceOwner:
 		ownable, isOwnable := obj.(models.Ownable)
 
 		if !isOwnable {
-			return nil, fmt.Errorf("This object can't be owned")
+			return nil, apperror.Forbidden(ctx)
 		}
 
 		_, claims, err := jwtauth.FromContext(ctx)
 
 		if err != nil {
-			return nil, err
+			return nil, apperror.Unauthenticated(ctx)
 		}
 
 		if claims["user_id"] == nil {
-			return nil, fmt.Errorf("Invalid token")
+			return nil, apperror.Unauthenticated(ctx)
 		}
 
 		if *ownable.OwnerID() != int(claims["user_id"].(float64)) {
-			return nil, fmt.Errorf("You are not own this resource")
+			return nil, apperror.Forbidden(ctx)
 		}
 
 	}
@@ -94,17 +215,30 @@ func HasRole(ctx context.Context, obj interface{}, next graphql.Resolver, role m
 	return next(ctx)
 }
 
-func Length(ctx context.Context, input interface{}, next graphql.Resolver, min *int, max *int) (interface{}, error) {
+// Length, Pattern, Email, Range, PhoneNumber and CountryCode are invoked by gqlgen while unmarshaling inputs.
+// The values are validated by ValidationMiddleware instead, so that every invalid
+// field is reported at once with its translated name.
+func Length(ctx context.Context, obj interface{}, next graphql.Resolver, min *int, max *int) (interface{}, error) {
+	return next(ctx)
+}
 
-	i, err := input.(int64)
+func Pattern(ctx context.Context, obj interface{}, next graphql.Resolver, regex string) (interface{}, error) {
+	return next(ctx)
+}
 
-	if !err {
-		return next(ctx)
-	}
+func Email(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	return next(ctx)
+}
 
-	if i < int64(*min) || i > int64(*max) {
-		return nil, fmt.Errorf("Arguments length must satisfied with min length: %d and max length: %d", *min, *max)
-	}
+func Range(ctx context.Context, obj interface{}, next graphql.Resolver, min *float64, max *float64) (interface{}, error) {
+	return next(ctx)
+}
+
+func PhoneNumber(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	return next(ctx)
+}
+
+func CountryCode(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
 	return next(ctx)
 }
 
@@ -113,11 +247,11 @@ func IsResourceOwner(ctx context.Context, obj interface{}, next graphql.Resolver
 	_, claims, err := jwtauth.FromContext(ctx)
 
 	if err != nil {
-		return nil, err
+		return nil, apperror.Unauthenticated(ctx)
 	}
 
 	if claims["roles"] == nil {
-		return nil, fmt.Errorf("no roles found")
+		return nil, apperror.Forbidden(ctx)
 	}
 
 	for _, v := range claims["roles"].([]interface{}) {
@@ -126,21 +260,12 @@ func IsResourceOwner(ctx context.Context, obj interface{}, next graphql.Resolver
 		}
 	}
 
-	// space owner
-	// check by casbin
-	for _, v := range claims["roles"].([]interface{}) {
-		if v.(string) == "ORGANIZATION_ADMIN" || v.(string) == "ORGANIZATION_MEMBER" {
-			// TODO implement casbin authz
-			return next(ctx)
-		}
-	}
-
 	// resource subject
 
 	ownable, isOwnable := obj.(models.Ownable)
 
 	if !isOwnable {
-		return nil, fmt.Errorf("This object can't be owned")
+		return nil, apperror.Forbidden(ctx)
 	}
 
 	if err != nil {
@@ -148,11 +273,11 @@ func IsResourceOwner(ctx context.Context, obj interface{}, next graphql.Resolver
 	}
 
 	if claims["user_id"] == nil {
-		return nil, fmt.Errorf("Invalid token")
+		return nil, apperror.Unauthenticated(ctx)
 	}
 
 	if *ownable.OwnerID() != int(claims["user_id"].(float64)) {
-		return nil, fmt.Errorf("You are not own this resource")
+		return nil, apperror.Forbidden(ctx)
 	}
 
 	return next(ctx)
@@ -0,0 +1,83 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/audit"
+	"github.com/shufo/go-graphql-boilerplate/graph/generated"
+	"github.com/shufo/go-graphql-boilerplate/mailqueue"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/pagination"
+	"github.com/volatiletech/sqlboiler/queries/qm"
+)
+
+type mailMessageResolver struct{ *Resolver }
+
+func (r *Resolver) MailMessage() generated.MailMessageResolver {
+	return &mailMessageResolver{r}
+}
+
+func (r *mailMessageResolver) Status(ctx context.Context, obj *models.MailMessage) (models.MailMessageStatus, error) {
+	return models.MailMessageStatus(strings.ToUpper(obj.Status)), nil
+}
+
+func (r *queryResolver) MailMessages(ctx context.Context, first *int, after *string, status *models.MailMessageStatus) (*models.MailMessageConnection, error) {
+	db := r.DB
+
+	conn, err := pagination.New(ctx, "MailMessage", pagination.Args{First: first, After: after}, pagination.Order{Desc: true})
+
+	if err != nil {
+		return nil, err
+	}
+
+	mods := []qm.QueryMod{}
+
+	if status != nil {
+		mods = append(mods, models.MailMessageWhere.Status.EQ(strings.ToLower(status.String())))
+	}
+
+	msgs, err := models.MailMessages(append(mods, conn.QueryMods()...)...).All(ctx, db)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &models.MailMessageConnection{
+		Edges: []models.MailMessageEdge{},
+	}
+
+	res.PageInfo = conn.Build(len(msgs), func(i int) string {
+		return conn.Cursor(msgs[i].ID, nil)
+	}, func(i int, cursor string) {
+		res.Edges = append(res.Edges, models.MailMessageEdge{Cursor: cursor, Node: *msgs[i]})
+	})
+
+	return res, nil
+}
+
+func (r *mutationResolver) RetryMailMessage(ctx context.Context, id int) (*models.MailMessage, error) {
+	msg, err := r.MailQueue.Retry(ctx, id)
+
+	switch err {
+	case nil:
+		r.recordAudit(ctx, r.DB, audit.Event{
+			Action:     audit.ActionAdmin,
+			TargetType: audit.TargetMailMessage,
+			TargetID:   &id,
+			Diff:       audit.Diff(nil, map[string]interface{}{"operation": "retryMailMessage"}),
+		})
+
+		return msg, nil
+	case sql.ErrNoRows:
+		return nil, apperror.New(ctx, apperror.CodeNotFound, "not_found")
+	case mailqueue.ErrNotFailed:
+		return nil, apperror.New(ctx, apperror.CodeConflict, "mail_message_not_failed")
+	case mailqueue.ErrRedacted:
+		return nil, apperror.New(ctx, apperror.CodeConflict, "mail_message_redacted")
+	default:
+		return nil, err
+	}
+}
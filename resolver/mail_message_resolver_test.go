@@ -0,0 +1,300 @@
+package resolver_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/machinebox/graphql"
+
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/shufo/go-graphql-boilerplate/mailqueue"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+)
+
+type MailMessageResolverSuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	client   *graphql.Client
+	fixtures *testfixtures.Context
+}
+
+// failingMailer fails to send every mail like an unavailable provider
+type failingMailer struct{}
+
+func (failingMailer) Send(ctx context.Context, m *mail.Mail) error {
+	return errors.New("provider unavailable")
+}
+
+// stalledMailer blocks until the context is done like a stalled connection
+type stalledMailer struct{}
+
+func (stalledMailer) Send(ctx context.Context, m *mail.Mail) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (suite *MailMessageResolverSuite) SetupSuite() {
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouter(suite.db)
+	suite.ts = httptest.NewServer(m)
+	suite.client = graphql.NewClient(suite.ts.URL + "/query")
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *MailMessageResolverSuite) TearDownSuite() {
+	suite.db.Close()
+}
+
+func (suite *MailMessageResolverSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (suite *MailMessageResolverSuite) authenticate(email string, password string) string {
+	req := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "` + email + `", password: "` + password + `"}) {
+				token
+			}
+		}
+	`)
+
+	var res map[string]map[string]interface{}
+	suite.client.Run(context.Background(), req, &res)
+
+	if res["authUser"] == nil {
+		return ""
+	}
+
+	return res["authUser"]["token"].(string)
+}
+
+// Super admin can look up the failed mails
+func (suite *MailMessageResolverSuite) TestMailMessages() {
+	ctx := context.Background()
+
+	req := graphql.NewRequest(`
+		query mailMessages {
+			mailMessages(status: FAILED) {
+				edges {
+					node {
+						id
+						status
+						attempts
+						lastError
+					}
+				}
+			}
+		}
+	`)
+
+	// normal user is not permitted
+	req.Header.Add("Authorization", "Bearer "+suite.authenticate("success@simulator.amazonses.com", "123456"))
+
+	var res map[string]map[string]interface{}
+	err := suite.client.Run(ctx, req, &res)
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "not granted")
+
+	req.Header.Set("Authorization", "Bearer "+suite.authenticate("admin@example.com", "123456"))
+
+	err = suite.client.Run(ctx, req, &res)
+	suite.NoError(err)
+
+	edges := res["mailMessages"]["edges"].([]interface{})
+	suite.Require().Len(edges, 1)
+
+	node := edges[0].(map[string]interface{})["node"].(map[string]interface{})
+	suite.Equal(float64(2), node["id"])
+	suite.Equal("FAILED", node["status"])
+	suite.Equal(float64(8), node["attempts"])
+	suite.Equal("connection refused", node["lastError"])
+}
+
+// Only failed mails are queued again
+func (suite *MailMessageResolverSuite) TestRetryMailMessage() {
+	ctx := context.Background()
+	adminToken := suite.authenticate("admin@example.com", "123456")
+
+	req := graphql.NewRequest(`
+		mutation retryMailMessage($id: Int!) {
+			retryMailMessage(id: $id) {
+				status
+				attempts
+			}
+		}
+	`)
+	req.Header.Add("Authorization", "Bearer "+adminToken)
+	req.Var("id", 2)
+
+	var res map[string]map[string]interface{}
+	err := suite.client.Run(ctx, req, &res)
+
+	suite.NoError(err)
+	suite.Equal("PENDING", res["retryMailMessage"]["status"])
+	suite.Equal(float64(0), res["retryMailMessage"]["attempts"])
+
+	event, err := models.AuditEvents(models.AuditEventWhere.Action.EQ("admin_action")).One(ctx, suite.db)
+	suite.Require().NoError(err)
+	suite.Equal(2, event.ActorID.Int)
+	suite.Equal("mail_message", event.TargetType.String)
+	suite.Equal(2, event.TargetID.Int)
+
+	// the sent mail is not sent again
+	req.Var("id", 1)
+
+	err = suite.client.Run(ctx, req, &res)
+	suite.Error(err)
+	suite.Contains(err.Error(), "Only failed mails")
+}
+
+// Undeliverable mails are retried with exponential backoff and marked as failed at last
+func (suite *MailMessageResolverSuite) TestRetryWithBackoff() {
+	ctx := context.Background()
+	clk := clock.NewMock(time.Now().Truncate(time.Second))
+
+	q := mailqueue.New(suite.db, failingMailer{}, clk, logrus.New(), configs.MailQueue{
+		MaxAttempts: 3,
+		Backoff:     time.Minute,
+		MaxBackoff:  time.Hour,
+		LockTimeout: 5 * time.Minute,
+		SendTimeout: time.Minute,
+	})
+
+	m := mail.New("success@simulator.amazonses.com")
+	m.SetSubject("subject")
+	m.SetHTMLBody("<p>body</p>")
+	m.SetTextBody("body")
+	suite.Require().NoError(q.Send(ctx, m))
+
+	msg, err := models.MailMessages(models.MailMessageWhere.Subject.EQ("subject")).One(ctx, suite.db)
+	suite.Require().NoError(err)
+
+	attempt := func() {
+		_, err := q.ProcessDue(ctx)
+		suite.Require().NoError(err)
+		suite.Require().NoError(msg.Reload(ctx, suite.db))
+	}
+
+	attempt()
+	suite.Equal(mailqueue.StatusPending, msg.Status)
+	suite.Equal(1, msg.Attempts)
+	suite.Equal("provider unavailable", msg.LastError.String)
+	suite.WithinDuration(clk.Now().Add(time.Minute), msg.NextAttemptAt, time.Second)
+
+	// not retried before the backoff
+	attempt()
+	suite.Equal(1, msg.Attempts)
+
+	clk.Add(time.Minute)
+	attempt()
+	suite.Equal(2, msg.Attempts)
+	suite.WithinDuration(clk.Now().Add(2*time.Minute), msg.NextAttemptAt, time.Second)
+
+	clk.Add(2 * time.Minute)
+	attempt()
+	suite.Equal(mailqueue.StatusFailed, msg.Status)
+	suite.Equal(3, msg.Attempts)
+}
+
+// Stalled deliveries are given up after the send timeout and retried later
+func (suite *MailMessageResolverSuite) TestSendTimeout() {
+	ctx := context.Background()
+
+	config := configs.Default().MailQueue
+	config.SendTimeout = 10 * time.Millisecond
+	q := mailqueue.New(suite.db, stalledMailer{}, clock.Real{}, logrus.New(), config)
+
+	m := mail.New("success@simulator.amazonses.com")
+	m.SetSubject("stalled")
+	m.SetHTMLBody("<p>body</p>")
+	m.SetTextBody("body")
+	suite.Require().NoError(q.Send(ctx, m))
+
+	sent, err := q.ProcessDue(ctx)
+	suite.Require().NoError(err)
+	suite.Equal(0, sent)
+
+	msg, err := models.MailMessages(models.MailMessageWhere.Subject.EQ("stalled")).One(ctx, suite.db)
+	suite.Require().NoError(err)
+	suite.Equal(mailqueue.StatusPending, msg.Status)
+	suite.Equal("context deadline exceeded", msg.LastError.String)
+}
+
+// Bodies of sensitive mails are cleared when they fail and they can't be retried
+func (suite *MailMessageResolverSuite) TestSensitiveMail() {
+	ctx := context.Background()
+
+	q := mailqueue.New(suite.db, failingMailer{}, clock.Real{}, logrus.New(), configs.MailQueue{
+		MaxAttempts: 1,
+		Backoff:     time.Minute,
+		MaxBackoff:  time.Hour,
+		LockTimeout: 5 * time.Minute,
+		SendTimeout: time.Minute,
+	})
+
+	m := mail.New("success@simulator.amazonses.com")
+	m.SetSubject("sensitive")
+	m.SetHTMLBody("<p>secret</p>")
+	m.SetTextBody("secret")
+	m.Sensitive = true
+	suite.Require().NoError(q.Send(ctx, m))
+
+	msg, err := models.MailMessages(models.MailMessageWhere.Subject.EQ("sensitive")).One(ctx, suite.db)
+	suite.Require().NoError(err)
+	suite.Equal("secret", msg.TextBody)
+
+	_, err = q.ProcessDue(ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(msg.Reload(ctx, suite.db))
+
+	suite.Equal(mailqueue.StatusFailed, msg.Status)
+	suite.Empty(msg.HTMLBody)
+	suite.Empty(msg.TextBody)
+
+	_, err = q.Retry(ctx, msg.ID)
+	suite.Equal(mailqueue.ErrRedacted, err)
+}
+
+// Mails with the same idempotency key are queued once
+func (suite *MailMessageResolverSuite) TestIdempotencyKey() {
+	ctx := context.Background()
+	q := mailqueue.New(suite.db, mail.NewOutbox(""), clock.Real{}, logrus.New(), configs.Default().MailQueue)
+
+	for i := 0; i < 2; i++ {
+		m := mail.New("success@simulator.amazonses.com")
+		m.SetSubject("subject")
+		m.SetHTMLBody("<p>body</p>")
+		m.SetTextBody("body")
+		m.IdempotencyKey = "welcome:1"
+
+		suite.NoError(q.Send(ctx, m))
+	}
+
+	count, err := models.MailMessages(models.MailMessageWhere.Subject.EQ("subject")).Count(ctx, suite.db)
+	suite.NoError(err)
+	suite.Equal(int64(1), count)
+}
+
+func TestMailMessageResolverSuite(t *testing.T) {
+	suite.Run(t, new(MailMessageResolverSuite))
+}
@@ -0,0 +1,171 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/auth"
+	"github.com/shufo/go-graphql-boilerplate/dataloader"
+	"github.com/shufo/go-graphql-boilerplate/graph/generated"
+	"github.com/shufo/go-graphql-boilerplate/models"
+)
+
+type authenticationProviderResolver struct{ *Resolver }
+
+func (r *Resolver) AuthenticationProvider() generated.AuthenticationProviderResolver {
+	return &authenticationProviderResolver{r}
+}
+
+type passwordResetResolver struct{ *Resolver }
+
+func (r *Resolver) PasswordReset() generated.PasswordResetResolver {
+	return &passwordResetResolver{r}
+}
+
+func (r *userResolver) ID(ctx context.Context, u *models.User) (string, error) {
+	return models.GlobalID(models.NodeTypeUser, u.ID), nil
+}
+
+func (r *authenticationProviderResolver) ID(ctx context.Context, ap *models.AuthenticationProvider) (string, error) {
+	return models.GlobalID(models.NodeTypeAuthenticationProvider, ap.ID), nil
+}
+
+func (r *passwordResetResolver) ID(ctx context.Context, pr *models.PasswordReset) (string, error) {
+	return models.GlobalID(models.NodeTypePasswordReset, pr.ID), nil
+}
+
+func (r *queryResolver) Node(ctx context.Context, id string) (models.Node, error) {
+	nodes, err := r.Nodes(ctx, []string{id})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes[0], nil
+}
+
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]models.Node, error) {
+	thunks := make([]func() (models.Node, error), len(ids))
+
+	// enqueue every id first so that dataloaders fetch them in a batch
+	for i, gid := range ids {
+		typ, id, err := models.ParseGlobalID(gid)
+
+		if err != nil {
+			return nil, apperror.New(ctx, apperror.CodeValidationFailed, "invalid_id")
+		}
+
+		thunk, ok := nodeThunk(ctx, typ, id)
+
+		if !ok {
+			return nil, apperror.New(ctx, apperror.CodeValidationFailed, "invalid_id")
+		}
+
+		thunks[i] = thunk
+	}
+
+	res := make([]models.Node, len(ids))
+
+	for i, thunk := range thunks {
+		node, err := thunk()
+
+		// ids which don't exist are resolved to null
+		if err != nil && apperror.CodeOf(err) != apperror.CodeNotFound {
+			return nil, err
+		}
+
+		visible, err := isNodeVisible(ctx, node)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if visible {
+			res[i] = node
+		}
+	}
+
+	return res, nil
+}
+
+// nodeThunk returns the function which resolves the node of the type via dataloader.
+// It returns false if the type is not a node.
+func nodeThunk(ctx context.Context, typ string, id int) (func() (models.Node, error), bool) {
+	ldrs := dataloader.CtxLoaders(ctx)
+
+	switch typ {
+	case models.NodeTypeUser:
+		thunk := ldrs.UserByID.LoadThunk(id)
+
+		return func() (models.Node, error) {
+			u, err := thunk()
+
+			if u == nil || err != nil {
+				return nil, err
+			}
+
+			return u, nil
+		}, true
+	case models.NodeTypeAuthenticationProvider:
+		thunk := ldrs.AuthenticationProviderByID.LoadThunk(id)
+
+		return func() (models.Node, error) {
+			ap, err := thunk()
+
+			if ap == nil || err != nil {
+				return nil, err
+			}
+
+			return ap, nil
+		}, true
+	case models.NodeTypePasswordReset:
+		thunk := ldrs.PasswordResetByID.LoadThunk(id)
+
+		return func() (models.Node, error) {
+			pr, err := thunk()
+
+			if pr == nil || err != nil {
+				return nil, err
+			}
+
+			return pr, nil
+		}, true
+	}
+
+	return nil, false
+}
+
+// isNodeVisible reports whether the requested user can fetch the node.
+// Users are public as well as `user` query. Authentication providers and
+// password resets are visible only to the owner.
+func isNodeVisible(ctx context.Context, node models.Node) (bool, error) {
+	switch n := node.(type) {
+	case nil:
+		return false, nil
+	case *models.User:
+		return true, nil
+	case *models.AuthenticationProvider:
+		return isOwner(ctx, n), nil
+	case *models.PasswordReset:
+		ap, err := dataloader.CtxLoaders(ctx).AuthenticationProviderByID.Load(n.AuthenticationProviderID)
+
+		if apperror.CodeOf(err) == apperror.CodeNotFound {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return isOwner(ctx, ap), nil
+	}
+
+	return false, nil
+}
+
+// isOwner reports whether the requested user owns the object
+func isOwner(ctx context.Context, obj models.Ownable) bool {
+	userID, ok := auth.UserIDForContext(ctx)
+
+	return ok && *obj.OwnerID() == userID
+}
@@ -0,0 +1,184 @@
+package resolver_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"expvar"
+	"log"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/machinebox/graphql"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type NodeResolverSuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	client   *graphql.Client
+	fixtures *testfixtures.Context
+}
+
+func (suite *NodeResolverSuite) SetupSuite() {
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouter(suite.db)
+	suite.ts = httptest.NewServer(m)
+	suite.client = graphql.NewClient(suite.ts.URL + "/query")
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *NodeResolverSuite) TearDownSuite() {
+	suite.db.Close()
+}
+
+func (suite *NodeResolverSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Refetch objects by global id
+func (suite *NodeResolverSuite) TestNodes() {
+	ctx := context.Background()
+
+	// authenticate as fixture user
+	authReq := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "success@simulator.amazonses.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	var authResponse map[string]map[string]interface{}
+	suite.NoError(suite.client.Run(ctx, authReq, &authResponse))
+	token := authResponse["authUser"]["token"].(string)
+
+	// global id is exposed as id
+	req := graphql.NewRequest(`
+		query user {
+			user(id: 1) {
+				id
+				databaseId
+			}
+		}
+	`)
+
+	var userResponse map[string]map[string]interface{}
+	suite.NoError(suite.client.Run(ctx, req, &userResponse))
+	suite.Equal(models.GlobalID(models.NodeTypeUser, 1), userResponse["user"]["id"])
+
+	req = graphql.NewRequest(`
+		query nodes($ids: [ID!]!) {
+			nodes(ids: $ids) {
+				__typename
+				id
+				... on User {
+					databaseId
+				}
+				... on AuthenticationProvider {
+					databaseId
+				}
+			}
+		}
+	`)
+	req.Var("ids", []string{
+		userResponse["user"]["id"].(string),
+		models.GlobalID(models.NodeTypeUser, 2),
+		models.GlobalID(models.NodeTypeAuthenticationProvider, 1),
+		// owned by another user
+		models.GlobalID(models.NodeTypeAuthenticationProvider, 2),
+		// not exists
+		models.GlobalID(models.NodeTypeUser, 100),
+	})
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	var res map[string][]map[string]interface{}
+	suite.NoError(suite.client.Run(ctx, req, &res))
+
+	suite.Len(res["nodes"], 5)
+	suite.Equal("User", res["nodes"][0]["__typename"])
+	suite.Equal(float64(1), res["nodes"][0]["databaseId"])
+	suite.Equal(float64(2), res["nodes"][1]["databaseId"])
+	suite.Equal("AuthenticationProvider", res["nodes"][2]["__typename"])
+	suite.Equal(models.GlobalID(models.NodeTypeAuthenticationProvider, 1), res["nodes"][2]["id"])
+	suite.Nil(res["nodes"][3])
+	suite.Nil(res["nodes"][4])
+}
+
+// Invalid global id is rejected
+func (suite *NodeResolverSuite) TestInvalidNodeID() {
+	ctx := context.Background()
+
+	for _, id := range []string{"invalid", models.GlobalID("AuditEvent", 1)} {
+		req := graphql.NewRequest(`
+			query node($id: ID!) {
+				node(id: $id) {
+					id
+				}
+			}
+		`)
+		req.Var("id", id)
+
+		var res map[string]map[string]interface{}
+		err := suite.client.Run(ctx, req, &res)
+
+		suite.Error(err)
+		suite.Contains(err.Error(), "id is invalid")
+	}
+}
+
+// Batches and cache hits of dataloaders are published by expvar
+func (suite *NodeResolverSuite) TestLoaderMetrics() {
+	stats := func() map[string]interface{} {
+		var v map[string]interface{}
+		suite.Require().NoError(json.Unmarshal([]byte(expvar.Get("dataloader").(*expvar.Map).Get("UserByID").String()), &v))
+
+		return v
+	}
+
+	before := stats()
+
+	req := graphql.NewRequest(`
+		query nodes($ids: [ID!]!) {
+			nodes(ids: $ids) {
+				id
+			}
+		}
+	`)
+	// the duplicated id is served from the loader and the missing one is fetched as well
+	req.Var("ids", []string{
+		models.GlobalID(models.NodeTypeUser, 1),
+		models.GlobalID(models.NodeTypeUser, 2),
+		models.GlobalID(models.NodeTypeUser, 1),
+		models.GlobalID(models.NodeTypeUser, 100),
+	})
+
+	var res map[string][]map[string]interface{}
+	suite.NoError(suite.client.Run(context.Background(), req, &res))
+	suite.Nil(res["nodes"][3])
+
+	after := stats()
+
+	suite.Equal(float64(4), after["loads"].(float64)-before["loads"].(float64))
+	suite.Equal(float64(1), after["batches"].(float64)-before["batches"].(float64))
+	suite.Equal(float64(3), after["keys"].(float64)-before["keys"].(float64))
+	suite.Equal(float64(1), after["cache_hits"].(float64)-before["cache_hits"].(float64))
+	suite.Equal(float64(1), after["not_found"].(float64)-before["not_found"].(float64))
+}
+
+func TestNodeResolverSuite(t *testing.T) {
+	suite.Run(t, new(NodeResolverSuite))
+}
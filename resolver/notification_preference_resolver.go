@@ -0,0 +1,68 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/auth"
+	"github.com/shufo/go-graphql-boilerplate/database"
+	"github.com/shufo/go-graphql-boilerplate/graph/generated"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/notification"
+)
+
+type notificationPreferenceResolver struct{ *Resolver }
+
+func (r *Resolver) NotificationPreference() generated.NotificationPreferenceResolver {
+	return &notificationPreferenceResolver{r}
+}
+
+func (r *notificationPreferenceResolver) Category(ctx context.Context, obj *notification.Preference) (models.NotificationCategory, error) {
+	return models.NotificationCategory(strings.ToUpper(string(obj.Category))), nil
+}
+
+func (r *notificationPreferenceResolver) Channel(ctx context.Context, obj *notification.Preference) (models.NotificationChannel, error) {
+	return models.NotificationChannel(strings.ToUpper(string(obj.Channel))), nil
+}
+
+func (r *notificationPreferenceResolver) Transactional(ctx context.Context, obj *notification.Preference) (bool, error) {
+	return obj.Category.Transactional(), nil
+}
+
+func (r *userResolver) NotificationPreferences(ctx context.Context, u *models.User) ([]notification.Preference, error) {
+	return notification.Preferences(ctx, r.DB, u.ID)
+}
+
+func (r *mutationResolver) UpdateNotificationPreferences(ctx context.Context, input models.UpdateNotificationPreferencesInput) ([]notification.Preference, error) {
+	userID, ok := auth.UserIDForContext(ctx)
+
+	if !ok {
+		return nil, apperror.Unauthenticated(ctx)
+	}
+
+	prefs := make([]notification.Preference, 0, len(input.Preferences))
+
+	for _, p := range input.Preferences {
+		prefs = append(prefs, notification.Preference{
+			Category: notification.Category(strings.ToLower(p.Category.String())),
+			Channel:  notification.Channel(strings.ToLower(p.Channel.String())),
+			Enabled:  p.Enabled,
+		})
+	}
+
+	err := database.Transaction(ctx, r.DB, func(ctx context.Context, tx *sql.Tx) error {
+		return notification.Set(ctx, tx, userID, prefs)
+	})
+
+	if err == notification.ErrTransactional {
+		return nil, apperror.New(ctx, apperror.CodeValidationFailed, "transactional_notification_required")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return notification.Preferences(ctx, r.DB, userID)
+}
@@ -0,0 +1,280 @@
+package resolver_test
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/machinebox/graphql"
+	"github.com/volatiletech/null"
+
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/shufo/go-graphql-boilerplate/mailqueue"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/notification"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationPreferenceResolverSuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	client   *graphql.Client
+	fixtures *testfixtures.Context
+}
+
+func (suite *NotificationPreferenceResolverSuite) SetupSuite() {
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouter(suite.db)
+	suite.ts = httptest.NewServer(m)
+	suite.client = graphql.NewClient(suite.ts.URL + "/query")
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *NotificationPreferenceResolverSuite) TearDownSuite() {
+	suite.db.Close()
+}
+
+func (suite *NotificationPreferenceResolverSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (suite *NotificationPreferenceResolverSuite) token() string {
+	req := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "success@simulator.amazonses.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	var res map[string]map[string]interface{}
+	suite.Require().NoError(suite.client.Run(context.Background(), req, &res))
+
+	return res["authUser"]["token"].(string)
+}
+
+// enabled returns the enabled flags of the preferences by "CATEGORY/CHANNEL"
+func enabled(prefs []interface{}) map[string]bool {
+	m := map[string]bool{}
+
+	for _, p := range prefs {
+		p := p.(map[string]interface{})
+		m[p["category"].(string)+"/"+p["channel"].(string)] = p["enabled"].(bool)
+	}
+
+	return m
+}
+
+// Users receive all but marketing notifications by default
+func (suite *NotificationPreferenceResolverSuite) TestNotificationPreferences() {
+	ctx := context.Background()
+
+	req := graphql.NewRequest(`
+		query user {
+			user {
+				notificationPreferences {
+					category
+					channel
+					enabled
+					transactional
+				}
+			}
+		}
+	`)
+	req.Header.Add("Authorization", "Bearer "+suite.token())
+
+	var res map[string]map[string][]interface{}
+	suite.Require().NoError(suite.client.Run(ctx, req, &res))
+
+	prefs := res["user"]["notificationPreferences"]
+	suite.Len(prefs, 6)
+	suite.Equal(map[string]bool{
+		"ACCOUNT/EMAIL":         true,
+		"ACCOUNT/PUSH":          true,
+		"PRODUCT_UPDATES/EMAIL": true,
+		"PRODUCT_UPDATES/PUSH":  true,
+		"MARKETING/EMAIL":       false,
+		"MARKETING/PUSH":        false,
+	}, enabled(prefs))
+	suite.Equal(true, prefs[0].(map[string]interface{})["transactional"])
+	suite.Equal(false, prefs[2].(map[string]interface{})["transactional"])
+
+	// other users can't see the preferences
+	req = graphql.NewRequest(`
+		query user {
+			user(id: 1) {
+				notificationPreferences {
+					enabled
+				}
+			}
+		}
+	`)
+
+	var anonymousResponse map[string]map[string]interface{}
+	suite.Error(suite.client.Run(ctx, req, &anonymousResponse))
+}
+
+// Preferences are changed except the transactional ones
+func (suite *NotificationPreferenceResolverSuite) TestUpdateNotificationPreferences() {
+	ctx := context.Background()
+	token := suite.token()
+
+	query := `
+		mutation updateNotificationPreferences($input: UpdateNotificationPreferencesInput!) {
+			updateNotificationPreferences(input: $input) {
+				category
+				channel
+				enabled
+			}
+		}
+	`
+
+	req := graphql.NewRequest(query)
+	req.Var("input", map[string]interface{}{"preferences": []map[string]interface{}{
+		{"category": "MARKETING", "channel": "EMAIL", "enabled": true},
+		{"category": "PRODUCT_UPDATES", "channel": "PUSH", "enabled": false},
+	}})
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	var res map[string][]interface{}
+	suite.Require().NoError(suite.client.Run(ctx, req, &res))
+
+	prefs := enabled(res["updateNotificationPreferences"])
+	suite.True(prefs["MARKETING/EMAIL"])
+	suite.False(prefs["MARKETING/PUSH"])
+	suite.True(prefs["PRODUCT_UPDATES/EMAIL"])
+	suite.False(prefs["PRODUCT_UPDATES/PUSH"])
+
+	// omitted preferences are not changed
+	req.Var("input", map[string]interface{}{"preferences": []map[string]interface{}{
+		{"category": "MARKETING", "channel": "EMAIL", "enabled": false},
+	}})
+
+	suite.Require().NoError(suite.client.Run(ctx, req, &res))
+
+	prefs = enabled(res["updateNotificationPreferences"])
+	suite.False(prefs["MARKETING/EMAIL"])
+	suite.False(prefs["PRODUCT_UPDATES/PUSH"])
+
+	// account notifications can't be disabled
+	req.Var("input", map[string]interface{}{"preferences": []map[string]interface{}{
+		{"category": "PRODUCT_UPDATES", "channel": "EMAIL", "enabled": false},
+		{"category": "ACCOUNT", "channel": "EMAIL", "enabled": false},
+	}})
+
+	err := suite.client.Run(ctx, req, &res)
+	suite.Error(err)
+	suite.Contains(err.Error(), "Account notifications can not be disabled")
+
+	allowed, err := notification.Allowed(ctx, suite.db, 1, notification.CategoryProductUpdates, notification.ChannelEmail)
+	suite.Require().NoError(err)
+	suite.True(allowed)
+
+	// anonymous users have no preferences
+	anonymous := graphql.NewRequest(query)
+	anonymous.Var("input", map[string]interface{}{"preferences": []map[string]interface{}{}})
+	suite.Error(suite.client.Run(ctx, anonymous, &res))
+}
+
+// Mails users can opt out of have the unsubscribe link which works with one click
+func (suite *NotificationPreferenceResolverSuite) TestUnsubscribe() {
+	ctx := context.Background()
+
+	templates, err := mail.NewTemplates()
+	suite.Require().NoError(err)
+
+	outbox := mail.NewOutbox("")
+	queue := mailqueue.New(suite.db, outbox, clock.Real{}, logrus.New(), configs.Default().MailQueue)
+	config := configs.Config{BaseURL: suite.ts.URL, UnsubscribeSecret: os.Getenv("UNSUBSCRIBE_SECRET"), UnsubscribeLifetime: time.Hour}
+	clk := clock.NewMock(time.Now())
+	notifier := notification.NewNotifier(suite.db, queue, templates, clk, config)
+
+	user := &models.User{ID: 1, Email: null.StringFrom("success@simulator.amazonses.com")}
+	data := map[string]interface{}{"Title": "New features", "Body": "We released new features."}
+
+	sent, err := notifier.Mail(ctx, user, notification.CategoryProductUpdates, "announcement", data)
+	suite.Require().NoError(err)
+	suite.True(sent)
+
+	// marketing mails are sent only to the users who opt in
+	sent, err = notifier.Mail(ctx, user, notification.CategoryMarketing, "announcement", data)
+	suite.Require().NoError(err)
+	suite.False(sent)
+
+	// the headers are kept in the queue
+	_, err = queue.ProcessDue(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(outbox.Mails(), 1)
+
+	m := outbox.Mails()[0]
+	unsubscribeURL := notification.UnsubscribeURL(suite.ts.URL, config.UnsubscribeSecret, 1, notification.CategoryProductUpdates, clk.Now().Add(time.Hour))
+
+	suite.Equal("<"+unsubscribeURL+">", m.Headers["List-Unsubscribe"])
+	suite.Equal("List-Unsubscribe=One-Click", m.Headers["List-Unsubscribe-Post"])
+	suite.Contains(m.TextBody, unsubscribeURL)
+
+	// the link shows the confirmation without unsubscribing
+	res, err := http.Get(unsubscribeURL)
+	suite.Require().NoError(err)
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+
+	suite.Equal(http.StatusOK, res.StatusCode)
+	suite.Contains(string(body), `<form method="post">`)
+
+	allowed, err := notification.Allowed(ctx, suite.db, 1, notification.CategoryProductUpdates, notification.ChannelEmail)
+	suite.Require().NoError(err)
+	suite.True(allowed)
+
+	// mail clients unsubscribe by POST to the URL
+	res, err = http.Post(unsubscribeURL, "application/x-www-form-urlencoded", strings.NewReader("List-Unsubscribe=One-Click"))
+	suite.Require().NoError(err)
+	res.Body.Close()
+
+	suite.Equal(http.StatusOK, res.StatusCode)
+
+	allowed, err = notification.Allowed(ctx, suite.db, 1, notification.CategoryProductUpdates, notification.ChannelEmail)
+	suite.Require().NoError(err)
+	suite.False(allowed)
+
+	sent, err = notifier.Mail(ctx, user, notification.CategoryProductUpdates, "announcement", data)
+	suite.Require().NoError(err)
+	suite.False(sent)
+
+	// forged, expired and auth-secret signed tokens are refused
+	for _, u := range []string{
+		notification.UnsubscribeURL(suite.ts.URL, "forged", 1, notification.CategoryMarketing, time.Now().Add(time.Hour)),
+		notification.UnsubscribeURL(suite.ts.URL, config.UnsubscribeSecret, 1, notification.CategoryMarketing, time.Now().Add(-time.Second)),
+		notification.UnsubscribeURL(suite.ts.URL, os.Getenv("JWT_SECRET"), 1, notification.CategoryMarketing, time.Now().Add(time.Hour)),
+	} {
+		res, err = http.Post(u, "application/x-www-form-urlencoded", strings.NewReader("List-Unsubscribe=One-Click"))
+		suite.Require().NoError(err)
+		res.Body.Close()
+
+		suite.Equal(http.StatusBadRequest, res.StatusCode, u)
+	}
+}
+
+func TestNotificationPreferenceResolverSuite(t *testing.T) {
+	suite.Run(t, new(NotificationPreferenceResolverSuite))
+}
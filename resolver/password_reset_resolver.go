@@ -2,33 +2,28 @@ package resolver
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
-	"time"
 
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/audit"
+	"github.com/shufo/go-graphql-boilerplate/database"
 	"github.com/shufo/go-graphql-boilerplate/mail"
 	"github.com/shufo/go-graphql-boilerplate/utils"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/volatiletech/null"
 
-	"github.com/99designs/gqlgen/graphql"
 	"github.com/shufo/go-graphql-boilerplate/models"
-	"github.com/shufo/go-graphql-boilerplate/translations"
 	"github.com/volatiletech/sqlboiler/boil"
 	"github.com/volatiletech/sqlboiler/queries/qm"
 )
 
 func (r *mutationResolver) RequestPasswordReset(ctx context.Context, input models.RequestPasswordResetInput) (*models.PasswordReset, error) {
-	if err := input.Validate(ctx); err != nil {
-		for k, v := range err {
-			graphql.AddErrorf(ctx, "%s: %s", k, v)
-		}
-		return nil, nil
-	}
-
 	// get db instance
-	db := ctx.Value("db").(*sql.DB)
+	db := r.DB
 
 	// check if user exists
 	ap, err := models.AuthenticationProviders(
@@ -36,131 +31,261 @@ func (r *mutationResolver) RequestPasswordReset(ctx context.Context, input model
 		qm.Where("provider_username = ?", input.Email),
 	).One(ctx, db)
 
+	// the response is the same as the registered email's in privacy mode
+	if err == sql.ErrNoRows && r.Config.PrivacyMode {
+		r.notifyEmailOwner(ctx, input.Email, "password_reset_unknown")
+		return r.anonymousPasswordReset(), nil
+	}
+
+	if err == sql.ErrNoRows {
+		return nil, apperror.New(ctx, apperror.CodeNotFound, "email_not_found")
+	}
+
+	// other errors like database outages are not reported as unregistered emails
 	if err != nil {
-		return nil, fmt.Errorf(translations.T(ctx, "email_not_found"))
+		return nil, err
 	}
 
+	// the token is only mailed. It can not be restored from the hash stored in db.
 	resetToken := utils.RandomUUID()
 
 	pr := &models.PasswordReset{
-		Status:             null.StringFrom("sent"),
-		PasswordResetToken: null.StringFrom(resetToken),
-		ExpiresAt:          null.TimeFrom(time.Now().Add(24 * time.Hour)),
+		Status:    null.StringFrom(models.PasswordResetsStatusSent),
+		TokenHash: null.StringFrom(hashPasswordResetToken(resetToken)),
+		ExpiresAt: null.TimeFrom(r.Clock.Now().Add(r.Config.PasswordResetLifetime)),
 	}
 
-	if err := pr.SetAuthenticationProvider(ctx, db, false, ap); err != nil {
-		return nil, err
-	}
+	// the reset email is queued only if the reset is recorded
+	err = database.Transaction(ctx, db, func(ctx context.Context, tx *sql.Tx) error {
+		// only the token in the latest mail can be used
+		if err := r.invalidatePasswordResets(ctx, tx, ap.ID); err != nil {
+			return err
+		}
 
-	if err := pr.Insert(ctx, db, boil.Infer()); err != nil {
-		return nil, err
-	}
+		if err := pr.SetAuthenticationProvider(ctx, tx, false, ap); err != nil {
+			return err
+		}
 
-	// send reset email
-	m := mail.New(input.Email)
-	m.SetSubject(translations.T(ctx, "subject_password_reset"))
+		if err := pr.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
 
-	variables := map[string]interface{}{
-		"ResetLink": resetToken,
-	}
+		if err := audit.Record(ctx, tx, audit.Event{
+			Action:     audit.ActionPasswordResetRequested,
+			ActorID:    &ap.UserID,
+			TargetType: audit.TargetPasswordReset,
+			TargetID:   &pr.ID,
+		}); err != nil {
+			return err
+		}
 
-	m.SetHTMLBody(translations.TWithTemplateData(ctx, "email_password_reset", variables))
-	m.SetTextBody(translations.TWithTemplateData(ctx, "email_password_reset", variables))
+		// send reset email
+		m, err := r.MailTemplates.NewMail(ctx, input.Email, "password_reset", map[string]interface{}{
+			"ResetLink": r.Config.PasswordResetLink(resetToken),
+		})
 
-	/* uncomment this if you want to really send email
+		if err != nil {
+			return err
+		}
+
+		m.IdempotencyKey = fmt.Sprintf("password_reset_requested:%d", pr.ID)
+		// the link must not be kept in the queue after it is sent
+		m.Sensitive = true
+
+		// the reset can not be completed without the mail. Mailer queues it in tx,
+		// so it is not sent if tx is rolled back nor queued twice if tx is retried.
+		return r.Mailer.Send(ctx, m)
+	})
+
+	if err == mail.ErrSuppressed && !r.Config.PrivacyMode {
+		return nil, apperror.New(ctx, apperror.CodeValidationFailed, "email_undeliverable")
+	}
 
-	if err := m.Send(); err != nil {
+	if err != nil && err != mail.ErrSuppressed {
 		return nil, err
 	}
 
-	*/
+	if r.Config.PrivacyMode {
+		return r.anonymousPasswordReset(), nil
+	}
 
 	return pr, nil
 }
 
-func (r *mutationResolver) ValidatePasswordReset(ctx context.Context, input models.ValidatePasswordResetInput) (*models.PasswordReset, error) {
-	// validate input
-	if err := input.Validate(ctx); err != nil {
-		for k, v := range err {
-			graphql.AddErrorf(ctx, "%s: %s", k, v)
-		}
-		return nil, nil
+// anonymousPasswordReset returns the response of the password reset which does not identify
+// the reset nor tell whether the email is registered
+func (r *mutationResolver) anonymousPasswordReset() *models.PasswordReset {
+	return &models.PasswordReset{
+		Status:    null.StringFrom(models.PasswordResetsStatusSent),
+		ExpiresAt: null.TimeFrom(r.Clock.Now().Add(r.Config.PasswordResetLifetime)),
 	}
+}
 
+func (r *mutationResolver) ValidatePasswordReset(ctx context.Context, input models.ValidatePasswordResetInput) (*models.PasswordReset, error) {
 	// get db instance
-	db := ctx.Value("db").(*sql.DB)
+	db := r.DB
 
 	// check if reset token exists
 	pr, err := models.PasswordResets(
-		qm.Where("password_reset_token = ?", input.Token),
-		qm.Where("status = ?", "sent"),
-		qm.Where("expires_at > ?", time.Now()),
+		models.PasswordResetWhere.TokenHash.EQ(null.StringFrom(hashPasswordResetToken(input.Token))),
+		models.PasswordResetWhere.Status.EQ(null.StringFrom(models.PasswordResetsStatusSent)),
+		qm.Where("expires_at > ?", r.Clock.Now()),
 	).One(ctx, db)
 
+	if err == sql.ErrNoRows {
+		return nil, apperror.New(ctx, apperror.CodeNotFound, "invalid_password_reset_token")
+	}
+
 	if err != nil {
-		return nil, fmt.Errorf(translations.T(ctx, "invalid_password_reset_token"))
+		return nil, err
 	}
 
-	pr.Status = null.StringFrom("verified")
+	// the token may be completed or invalidated after it is read
+	verified, err := r.transitionPasswordReset(ctx, db, pr, models.PasswordResetsStatusSent, models.PasswordResetsStatusVerified)
 
-	if _, err := pr.Update(ctx, db, boil.Infer()); err != nil {
+	if err != nil {
 		return nil, err
 	}
 
+	if !verified {
+		return nil, apperror.New(ctx, apperror.CodeNotFound, "invalid_password_reset_token")
+	}
+
 	return pr, nil
 }
 
 func (r *mutationResolver) CompletePasswordReset(ctx context.Context, input models.CompletePasswordResetInput) (*models.AuthenticationProvider, error) {
-	// validate input
-	if err := input.Validate(ctx); err != nil {
-		for k, v := range err {
-			graphql.AddErrorf(ctx, "%s: %s", k, v)
+	var ap *models.AuthenticationProvider
+
+	err := database.Transaction(ctx, r.DB, func(ctx context.Context, tx *sql.Tx) error {
+		// check if reset token exists. The token can be used only once before it expires.
+		pr, err := models.PasswordResets(
+			models.PasswordResetWhere.TokenHash.EQ(null.StringFrom(hashPasswordResetToken(input.Token))),
+			models.PasswordResetWhere.Status.EQ(null.StringFrom(models.PasswordResetsStatusVerified)),
+			qm.Where("expires_at > ?", r.Clock.Now()),
+			qm.Load("AuthenticationProvider"),
+		).One(ctx, tx)
+
+		if err == sql.ErrNoRows {
+			return apperror.New(ctx, apperror.CodeNotFound, "verified_token_not_found")
 		}
-		return nil, nil
-	}
 
-	// get db instance
-	db := ctx.Value("db").(*sql.DB)
+		if err != nil {
+			return err
+		}
 
-	// check if reset token exists
-	pr, err := models.PasswordResets(
-		qm.Where("password_reset_token = ?", input.Token),
-		qm.Where("status = ?", "verified"),
-		qm.Load("AuthenticationProvider"),
-	).One(ctx, db)
+		// the token is claimed before the password is changed, so that only one of the
+		// concurrent completions with the same token changes it
+		claimed, err := r.transitionPasswordReset(ctx, tx, pr, models.PasswordResetsStatusVerified, models.PasswordResetsStatusCompleted)
 
-	if err != nil {
-		return nil, fmt.Errorf(translations.T(ctx, "verified_token_not_found"))
-	}
+		if err != nil {
+			return err
+		}
 
-	ap := pr.R.AuthenticationProvider
+		if !claimed {
+			return apperror.New(ctx, apperror.CodeNotFound, "verified_token_not_found")
+		}
 
-	// update authentication provider with new password
-	hashed, _ := bcrypt.GenerateFromPassword([]byte(input.NewPassword), bcrypt.MinCost)
-	ap.ProviderPassword = string(hashed)
+		ap = pr.R.AuthenticationProvider
 
-	if _, err := ap.Update(ctx, db, boil.Infer()); err != nil {
-		return nil, err
-	}
+		// update authentication provider with new password
+		hashed, _ := bcrypt.GenerateFromPassword([]byte(input.NewPassword), bcrypt.MinCost)
+		ap.ProviderPassword = string(hashed)
 
-	// send complete email
-	m := mail.New(ap.Email.String)
-	m.SetSubject(translations.T(ctx, "subject_password_reset_complete"))
+		if _, err := ap.Update(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
 
-	variables := map[string]interface{}{
-		"email": ap.Email.String,
-	}
+		// the other outstanding tokens can not be used after the password is changed
+		if err := r.invalidatePasswordResets(ctx, tx, ap.ID); err != nil {
+			return err
+		}
+
+		if err := audit.Record(ctx, tx, audit.Event{
+			Action:     audit.ActionPasswordResetCompleted,
+			ActorID:    &ap.UserID,
+			TargetType: audit.TargetAuthenticationProvider,
+			TargetID:   &ap.ID,
+		}); err != nil {
+			return err
+		}
+
+		// send complete email to the login identifier as the email column may be null
+		m, err := r.MailTemplates.NewMail(ctx, ap.ProviderUsername, "password_reset_complete", map[string]interface{}{
+			"Email": ap.ProviderUsername,
+		})
 
-	m.SetHTMLBody(translations.TWithTemplateData(ctx, "email_password_reset_complete", variables))
-	m.SetTextBody(translations.TWithTemplateData(ctx, "email_password_reset_complete", variables))
+		if err != nil {
+			return err
+		}
+
+		m.IdempotencyKey = fmt.Sprintf("password_reset_completed:%d", pr.ID)
 
-	/* uncomment this if you want to really send email
+		// the password is changed even if the notice can not be delivered.
+		// Mailer queues it in tx like the reset mail.
+		if err := r.Mailer.Send(ctx, m); err != nil && err != mail.ErrSuppressed {
+			return err
+		}
 
-	if err := m.Send(); err != nil {
+		return nil
+	})
+
+	if err != nil {
 		return nil, err
 	}
 
-	*/
+	// subscribers are notified only after the password is changed
+	if err := r.publish(ctx, passwordChangedTopic(ap.UserID), &models.PasswordChangedEvent{
+		UserID:    ap.UserID,
+		ChangedAt: r.Clock.Now(),
+	}); err != nil {
+		return nil, err
+	}
 
 	return ap, nil
 }
+
+// hashPasswordResetToken returns the hash of the token stored in db instead of the token
+func hashPasswordResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// transitionPasswordReset changes the status of the unexpired password reset from one to another
+// by the conditional update, and reports whether it is changed. It is not changed if another
+// request has changed the status since the password reset was read.
+func (r *mutationResolver) transitionPasswordReset(ctx context.Context, exec boil.ContextExecutor, pr *models.PasswordReset, from string, to string) (bool, error) {
+	now := r.Clock.Now()
+
+	n, err := models.PasswordResets(
+		models.PasswordResetWhere.ID.EQ(pr.ID),
+		models.PasswordResetWhere.Status.EQ(null.StringFrom(from)),
+		qm.Where("expires_at > ?", now),
+	).UpdateAll(ctx, exec, models.M{
+		"status":     to,
+		"updated_at": now,
+	})
+
+	if err != nil || n == 0 {
+		return false, err
+	}
+
+	pr.Status = null.StringFrom(to)
+	pr.UpdatedAt = null.TimeFrom(now)
+
+	return true, nil
+}
+
+// invalidatePasswordResets invalidates the outstanding password resets of the authentication provider
+func (r *mutationResolver) invalidatePasswordResets(ctx context.Context, exec boil.ContextExecutor, authenticationProviderID int) error {
+	_, err := models.PasswordResets(
+		models.PasswordResetWhere.AuthenticationProviderID.EQ(authenticationProviderID),
+		qm.WhereIn("status IN ?", models.PasswordResetsStatusSent, models.PasswordResetsStatusVerified),
+	).UpdateAll(ctx, exec, models.M{
+		"status":     models.PasswordResetsStatusInvalidated,
+		"updated_at": r.Clock.Now(),
+	})
+
+	return err
+}
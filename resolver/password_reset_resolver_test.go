@@ -1,17 +1,30 @@
 package resolver_test
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"net/http/httptest"
 	"os"
+	"regexp"
 	"testing"
 
-	"github.com/shufo/go-graphql-boilerplate/testutils"
 	"github.com/go-testfixtures/testfixtures"
 	"github.com/machinebox/graphql"
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/shufo/go-graphql-boilerplate/mailqueue"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/queries"
+	"github.com/volatiletech/sqlboiler/queries/qm"
 )
 
 type PasswordResetResolverSuite struct {
@@ -20,6 +33,8 @@ type PasswordResetResolverSuite struct {
 	ts       *httptest.Server
 	client   *graphql.Client
 	fixtures *testfixtures.Context
+	outbox   *mail.Outbox
+	queue    *mailqueue.Queue
 }
 
 func (suite *PasswordResetResolverSuite) SetupSuite() {
@@ -34,6 +49,10 @@ func (suite *PasswordResetResolverSuite) SetupSuite() {
 	}
 	suite.fixtures = fixtures
 
+	// deliver the queued mails to the outbox
+	suite.outbox = mail.NewOutbox("")
+	suite.queue = mailqueue.New(suite.db, suite.outbox, clock.Real{}, logrus.New(), configs.Default().MailQueue)
+
 	os.Setenv("APP_ENV", "test")
 }
 
@@ -102,6 +121,31 @@ func (s *PasswordResetResolverSuite) Test_mutationResolver_RequestPasswordReset(
 	}
 }
 
+// Database errors are not reported as unregistered emails
+func (s *PasswordResetResolverSuite) TestRequestPasswordResetDatabaseError() {
+	// the database which can't be connected
+	db, err := sql.Open("mysql", "root@tcp(127.0.0.1:1)/example")
+	s.Require().NoError(err)
+	defer db.Close()
+
+	ts := httptest.NewServer(testutils.PrepareRouter(db))
+	defer ts.Close()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"query": `mutation { requestPasswordReset(input: {email: "success@simulator.amazonses.com"}) { status } }`,
+	})
+	res, err := http.Post(ts.URL+"/query", "application/json", bytes.NewReader(body))
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	var payload map[string]interface{}
+	s.Require().NoError(json.NewDecoder(res.Body).Decode(&payload))
+	s.Equal("INTERNAL", errorCode(payload))
+}
+
+// resetLink matches the token in the link of the reset mail
+var resetLink = regexp.MustCompile(`/password/reset\?token=([0-9a-f\-]+)`)
+
 func (s *PasswordResetResolverSuite) Test_mutationResolver_ValidatePasswordReset() {
 	// test cases
 	cases := []struct {
@@ -169,6 +213,20 @@ func (s *PasswordResetResolverSuite) Test_mutationResolver_CompletePasswordReset
 			valid:    true,
 			want:     "success@simulator.amazonses.com",
 		},
+		{
+			name:     "case: token is already used",
+			token:    "valid_password_reset_token",
+			password: "1234abcd",
+			valid:    false,
+			wantErr:  "no verified password reset token",
+		},
+		{
+			name:     "case: token is expired",
+			token:    "expired_password_reset_token",
+			password: "1234abcd",
+			valid:    false,
+			wantErr:  "no verified password reset token",
+		},
 		{
 			name:     "case: password is too short",
 			token:    "valid_password_reset_token",
@@ -213,6 +271,192 @@ func (s *PasswordResetResolverSuite) Test_mutationResolver_CompletePasswordReset
 	}
 }
 
+// Password reset mails are queued and delivered by the queue
+func (s *PasswordResetResolverSuite) TestPasswordResetMails() {
+	ctx := context.Background()
+
+	sent := func() []*mail.Mail {
+		_, err := s.queue.ProcessDue(ctx)
+		s.Require().NoError(err)
+
+		return s.outbox.Mails()
+	}
+
+	before := len(sent())
+
+	req := graphql.NewRequest(`
+		mutation requestPasswordReset {
+			requestPasswordReset(input: {email: "success@simulator.amazonses.com"}) {
+				status
+			}
+		}
+	`)
+
+	var res map[string]map[string]interface{}
+	s.NoError(s.client.Run(ctx, req, &res))
+
+	mails := sent()
+	s.Require().Len(mails, before+1)
+	s.Equal("success@simulator.amazonses.com", mails[before].Recipient)
+	s.Equal("Change password for example", mails[before].Subject)
+	// styles are inlined and the text is generated from the html
+	s.Contains(mails[before].HTMLBody, `<h1 style="`)
+	s.Contains(mails[before].TextBody, "Reset your password")
+	s.NotContains(mails[before].TextBody, "<")
+
+	// the mail links to the reset page with the token
+	link := resetLink.FindStringSubmatch(mails[before].TextBody)
+	s.Require().Len(link, 2)
+	token := link[1]
+
+	// the link is not kept in the queue after it is sent
+	msg, err := models.MailMessages(qm.Where("subject = ?", "Change password for example"), qm.OrderBy("id DESC")).One(ctx, s.db)
+	s.Require().NoError(err)
+	s.True(msg.Sensitive)
+	s.Equal(mailqueue.StatusSent, msg.Status)
+	s.Empty(msg.HTMLBody)
+	s.Empty(msg.TextBody)
+
+	// only the hash of the token is stored
+	pr, err := models.PasswordResets(qm.OrderBy("id DESC")).One(ctx, s.db)
+	s.Require().NoError(err)
+	s.Equal(models.PasswordResetsStatusSent, pr.Status.String)
+	s.NotContains(pr.TokenHash.String, token)
+
+	complete := func(token string) error {
+		req := graphql.NewRequest(`
+			mutation completePasswordReset($token: String!) {
+				completePasswordReset(input: {token: $token, newPassword: "1234abcd"}) {
+					email
+				}
+			}
+		`)
+		req.Var("token", token)
+
+		return s.client.Run(ctx, req, &res)
+	}
+
+	// the tokens requested before are invalidated
+	s.Error(complete("valid_password_reset_token"))
+
+	// the notice is sent to the login identifier even if the email column is null
+	_, err = queries.Raw("UPDATE `authentication_providers` SET `email` = NULL WHERE `id` = 1").ExecContext(ctx, s.db)
+	s.Require().NoError(err)
+
+	req = graphql.NewRequest(`
+		mutation validatePasswordReset($token: String!) {
+			validatePasswordReset(input: {token: $token}) {
+				status
+			}
+		}
+	`)
+	req.Var("token", token)
+
+	s.NoError(s.client.Run(ctx, req, &res))
+	s.NoError(complete(token))
+
+	mails = sent()
+	s.Require().Len(mails, before+2)
+	s.Equal("success@simulator.amazonses.com", mails[before+1].Recipient)
+
+	// the token can be used only once
+	s.Error(complete(token))
+
+	s.Require().NoError(pr.Reload(ctx, s.db))
+	s.Equal(models.PasswordResetsStatusCompleted, pr.Status.String)
+}
+
+// Only one of the concurrent completions with the same token changes the password
+func (s *PasswordResetResolverSuite) TestConcurrentPasswordResetCompletions() {
+	ctx := context.Background()
+	errs := make(chan error, 5)
+
+	// the router of its own not to use up the rate limit of the other tests
+	ts := httptest.NewServer(testutils.PrepareRouter(s.db))
+	defer ts.Close()
+
+	client := graphql.NewClient(ts.URL + "/query")
+
+	for i := 0; i < cap(errs); i++ {
+		go func() {
+			req := graphql.NewRequest(`
+				mutation completePasswordReset {
+					completePasswordReset(input: {token: "valid_password_reset_token", newPassword: "1234abcd"}) {
+						email
+					}
+				}
+			`)
+
+			var res map[string]map[string]interface{}
+			errs <- client.Run(ctx, req, &res)
+		}()
+	}
+
+	completed := 0
+
+	for i := 0; i < cap(errs); i++ {
+		if err := <-errs; err == nil {
+			completed++
+		} else {
+			s.Contains(err.Error(), "no verified password reset token")
+		}
+	}
+
+	s.Equal(1, completed)
+}
+
+// Mails are rendered in the requested language
+func (s *PasswordResetResolverSuite) TestPasswordResetMailLanguage() {
+	ctx := context.Background()
+
+	req := graphql.NewRequest(`
+		mutation requestPasswordReset {
+			requestPasswordReset(input: {email: "success@simulator.amazonses.com"}) {
+				status
+			}
+		}
+	`)
+	req.Header.Set("Accept-Language", "ja-JP,ja;q=0.9,en;q=0.8")
+
+	var res map[string]map[string]interface{}
+	s.NoError(s.client.Run(ctx, req, &res))
+
+	_, err := s.queue.ProcessDue(ctx)
+	s.Require().NoError(err)
+
+	mails := s.outbox.Mails()
+	s.Require().NotEmpty(mails)
+	s.Equal("パスワード再設定のご案内", mails[len(mails)-1].Subject)
+	s.Contains(mails[len(mails)-1].TextBody, "パスワードの再設定")
+}
+
+// Mails can be previewed in non-production environments
+func (s *PasswordResetResolverSuite) TestMailPreview() {
+	res, err := http.Get(s.ts.URL + "/mail/preview/en/password_reset?ResetLink=https://example.jp/reset")
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	body, _ := ioutil.ReadAll(res.Body)
+
+	s.Equal(http.StatusOK, res.StatusCode)
+	s.Contains(string(body), `href="https://example.jp/reset"`)
+
+	res, err = http.Get(s.ts.URL + "/mail/preview/en/password_reset?format=text")
+	s.Require().NoError(err)
+	defer res.Body.Close()
+
+	body, _ = ioutil.ReadAll(res.Body)
+
+	s.Equal(http.StatusOK, res.StatusCode)
+	s.Contains(string(body), "Subject: Change password for example")
+
+	res, err = http.Get(s.ts.URL + "/mail/preview/en/unknown")
+	s.Require().NoError(err)
+	res.Body.Close()
+
+	s.Equal(http.StatusNotFound, res.StatusCode)
+}
+
 func TestPasswordResetResolverSuite(t *testing.T) {
 	suite.Run(t, new(PasswordResetResolverSuite))
 }
@@ -0,0 +1,159 @@
+package resolver_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-testfixtures/testfixtures"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/shufo/go-graphql-boilerplate/persisted"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type PersistedQuerySuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	fixtures *testfixtures.Context
+}
+
+type persistedQueryResponse struct {
+	Data   map[string]interface{}   `json:"data"`
+	Errors []map[string]interface{} `json:"errors"`
+}
+
+const persistedQuery = `query user { user(id: 1) { databaseId } }`
+
+func (suite *PersistedQuerySuite) SetupSuite() {
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouter(suite.db)
+	suite.ts = httptest.NewServer(m)
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *PersistedQuerySuite) TearDownSuite() {
+	suite.db.Close()
+}
+
+func (suite *PersistedQuerySuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (suite *PersistedQuerySuite) post(ts *httptest.Server, query string, hash string) persistedQueryResponse {
+	params := map[string]interface{}{}
+
+	if query != "" {
+		params["query"] = query
+	}
+
+	if hash != "" {
+		params["extensions"] = map[string]interface{}{
+			"persistedQuery": map[string]interface{}{"version": 1, "sha256Hash": hash},
+		}
+	}
+
+	body, _ := json.Marshal(params)
+	res, err := http.Post(ts.URL+"/query", "application/json", bytes.NewReader(body))
+	suite.Require().NoError(err)
+	defer res.Body.Close()
+
+	var payload persistedQueryResponse
+	suite.Require().NoError(json.NewDecoder(res.Body).Decode(&payload))
+
+	return payload
+}
+
+// Automatic persisted query flow of Apollo client
+func (suite *PersistedQuerySuite) TestAutomaticPersistedQuery() {
+	// unique query not to hit the query registered in Redis by previous runs
+	query := fmt.Sprintf("# %d\n%s", time.Now().UnixNano(), persistedQuery)
+	hash := persisted.Hash(query)
+
+	// unknown hash
+	res := suite.post(suite.ts, "", hash)
+	suite.Len(res.Errors, 1)
+	suite.Equal("PersistedQueryNotFound", res.Errors[0]["message"])
+
+	// register the query with the hash
+	res = suite.post(suite.ts, query, hash)
+	suite.Empty(res.Errors)
+	suite.Equal(float64(1), res.Data["user"].(map[string]interface{})["databaseId"])
+
+	// hash only
+	res = suite.post(suite.ts, "", hash)
+	suite.Empty(res.Errors)
+	suite.Equal(float64(1), res.Data["user"].(map[string]interface{})["databaseId"])
+
+	// GET request with the hash
+	extensions := `{"persistedQuery":{"version":1,"sha256Hash":"` + hash + `"}}`
+	getRes, err := http.Get(suite.ts.URL + "/query?extensions=" + url.QueryEscape(extensions))
+	suite.Require().NoError(err)
+	defer getRes.Body.Close()
+
+	var payload persistedQueryResponse
+	suite.NoError(json.NewDecoder(getRes.Body).Decode(&payload))
+	suite.Empty(payload.Errors)
+	suite.Equal(float64(1), payload.Data["user"].(map[string]interface{})["databaseId"])
+
+	// hash doesn't match with the query
+	res = suite.post(suite.ts, `query { user(id: 2) { databaseId } }`, hash)
+	suite.Len(res.Errors, 1)
+	suite.Equal("PERSISTED_QUERY_HASH_MISMATCH", res.Errors[0]["extensions"].(map[string]interface{})["code"])
+}
+
+// Only queries in the manifest are accepted in strict mode
+func (suite *PersistedQuerySuite) TestManifest() {
+	f, err := ioutil.TempFile("", "manifest*.json")
+	suite.Require().NoError(err)
+	defer os.Remove(f.Name())
+
+	json.NewEncoder(f).Encode(map[string]string{persisted.Hash(persistedQuery): persistedQuery})
+	f.Close()
+
+	os.Setenv("PERSISTED_QUERY_MANIFEST", f.Name())
+	ts := httptest.NewServer(testutils.PrepareRouter(suite.db))
+	os.Unsetenv("PERSISTED_QUERY_MANIFEST")
+	defer ts.Close()
+
+	// hash in the manifest
+	res := suite.post(ts, "", persisted.Hash(persistedQuery))
+	suite.Empty(res.Errors)
+	suite.Equal(float64(1), res.Data["user"].(map[string]interface{})["databaseId"])
+
+	// query text in the manifest
+	res = suite.post(ts, persistedQuery, "")
+	suite.Empty(res.Errors)
+
+	// arbitrary query
+	for _, r := range []persistedQueryResponse{
+		suite.post(ts, `query { user(id: 2) { databaseId } }`, ""),
+		suite.post(ts, "", persisted.Hash(`query { user(id: 2) { databaseId } }`)),
+	} {
+		suite.Len(r.Errors, 1)
+		suite.Equal("PERSISTED_QUERY_NOT_ALLOWED", r.Errors[0]["extensions"].(map[string]interface{})["code"])
+		suite.Nil(r.Data)
+	}
+}
+
+func TestPersistedQuerySuite(t *testing.T) {
+	suite.Run(t, new(PersistedQuerySuite))
+}
@@ -0,0 +1,153 @@
+package resolver_test
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/machinebox/graphql"
+
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/shufo/go-graphql-boilerplate/mailqueue"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+)
+
+type PrivacyModeSuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	client   *graphql.Client
+	fixtures *testfixtures.Context
+	outbox   *mail.Outbox
+	queue    *mailqueue.Queue
+}
+
+func (suite *PrivacyModeSuite) SetupSuite() {
+	// the config is read from the environment by the router
+	os.Setenv("PRIVACY_MODE", "true")
+
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouter(suite.db)
+	suite.ts = httptest.NewServer(m)
+	suite.client = graphql.NewClient(suite.ts.URL + "/query")
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+
+	suite.outbox = mail.NewOutbox("")
+	suite.queue = mailqueue.New(suite.db, suite.outbox, clock.Real{}, logrus.New(), configs.Default().MailQueue)
+}
+
+func (suite *PrivacyModeSuite) TearDownSuite() {
+	os.Unsetenv("PRIVACY_MODE")
+	suite.db.Close()
+}
+
+func (suite *PrivacyModeSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// sent delivers the queued mails and returns the mails sent after the first before mails
+func (suite *PrivacyModeSuite) sent(before int) []*mail.Mail {
+	_, err := suite.queue.ProcessDue(context.Background())
+	suite.Require().NoError(err)
+
+	return suite.outbox.Mails()[before:]
+}
+
+// Signup with the registered email succeeds and the owner is notified
+func (suite *PrivacyModeSuite) TestCreateUser() {
+	ctx := context.Background()
+	before := len(suite.sent(0))
+
+	req := graphql.NewRequest(`
+		mutation createUser($email: String!) {
+			createUser(input: {email: $email, password: "123456", firstName: "shuhei", lastName: "hayashibara", phoneNumber: "03-1234-5678"}) {
+				id
+				token
+			}
+		}
+	`)
+
+	var registered, created map[string]map[string]interface{}
+
+	req.Var("email", "success@simulator.amazonses.com")
+	suite.Require().NoError(suite.client.Run(ctx, req, &registered))
+
+	req.Var("email", "privacy@example.com")
+	suite.Require().NoError(suite.client.Run(ctx, req, &created))
+
+	// users are not signed in by the response
+	suite.Equal(map[string]interface{}{"id": nil, "token": nil}, registered["createUser"])
+	suite.Equal(registered, created)
+
+	mails := suite.sent(before)
+	suite.Require().Len(mails, 1)
+	suite.Equal("success@simulator.amazonses.com", mails[0].Recipient)
+	suite.Equal("Sign up attempt with your email address", mails[0].Subject)
+
+	// the new user signs in with the password
+	req = graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "privacy@example.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	var res map[string]map[string]interface{}
+	suite.Require().NoError(suite.client.Run(ctx, req, &res))
+	suite.NotEmpty(res["authUser"]["token"])
+}
+
+// Password reset of the unknown email succeeds and the owner is notified
+func (suite *PrivacyModeSuite) TestRequestPasswordReset() {
+	ctx := context.Background()
+	before := len(suite.sent(0))
+
+	req := graphql.NewRequest(`
+		mutation requestPasswordReset($email: String!) {
+			requestPasswordReset(input: {email: $email}) {
+				id
+				databaseId
+				status
+			}
+		}
+	`)
+
+	var registered, unknown map[string]map[string]interface{}
+
+	req.Var("email", "success@simulator.amazonses.com")
+	suite.Require().NoError(suite.client.Run(ctx, req, &registered))
+
+	req.Var("email", "unknown@example.com")
+	suite.Require().NoError(suite.client.Run(ctx, req, &unknown))
+
+	suite.Equal("sent", registered["requestPasswordReset"]["status"])
+	suite.Equal(float64(0), registered["requestPasswordReset"]["databaseId"])
+	suite.Equal(registered, unknown)
+
+	mails := suite.sent(before)
+	suite.Require().Len(mails, 2)
+	suite.Equal("success@simulator.amazonses.com", mails[0].Recipient)
+	suite.Equal("Change password for example", mails[0].Subject)
+	suite.Equal("unknown@example.com", mails[1].Recipient)
+	suite.Equal("Password reset request", mails[1].Subject)
+}
+
+func TestPrivacyModeSuite(t *testing.T) {
+	suite.Run(t, new(PrivacyModeSuite))
+}
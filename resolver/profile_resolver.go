@@ -0,0 +1,76 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/auth"
+	"github.com/shufo/go-graphql-boilerplate/dataloader"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+func (r *userResolver) Profile(ctx context.Context, u *models.User) (*models.Profile, error) {
+	p, err := dataloader.CtxLoaders(ctx).ProfileByUserID.Load(u.ID)
+
+	// users created before profiles were introduced have no profile
+	if apperror.CodeOf(err) == apperror.CodeNotFound {
+		return nil, nil
+	}
+
+	return p, err
+}
+
+func (r *mutationResolver) UpdateProfile(ctx context.Context, input models.UpdateProfileInput) (*models.Profile, error) {
+	db := r.DB
+
+	userID, ok := auth.UserIDForContext(ctx)
+
+	if !ok {
+		return nil, apperror.Unauthenticated(ctx)
+	}
+
+	p, err := models.Profiles(models.ProfileWhere.UserID.EQ(userID)).One(ctx, db)
+
+	switch {
+	case err == sql.ErrNoRows:
+		// users created before profiles were introduced have no profile
+		p = &models.Profile{UserID: userID}
+	case err != nil:
+		return nil, err
+	}
+
+	if input.FirstName != nil {
+		p.FirstName = null.StringFrom(*input.FirstName)
+	}
+
+	if input.LastName != nil {
+		p.LastName = null.StringFrom(*input.LastName)
+	}
+
+	if input.FullName != nil {
+		p.FullName = null.StringFrom(*input.FullName)
+	}
+
+	if input.PhoneNumber != nil {
+		p.PhoneNumber = null.StringFrom(*input.PhoneNumber)
+	}
+
+	if input.Country != nil {
+		p.Country = null.StringFrom(*input.Country)
+	}
+
+	if p.ID == 0 {
+		err = p.Insert(ctx, db, boil.Infer())
+	} else {
+		_, err = p.Update(ctx, db, boil.Infer())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
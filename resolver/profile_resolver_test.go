@@ -0,0 +1,183 @@
+package resolver_test
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/machinebox/graphql"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProfileResolverSuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	client   *graphql.Client
+	fixtures *testfixtures.Context
+}
+
+func (suite *ProfileResolverSuite) SetupSuite() {
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouter(suite.db)
+	suite.ts = httptest.NewServer(m)
+	suite.client = graphql.NewClient(suite.ts.URL + "/query")
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *ProfileResolverSuite) TearDownSuite() {
+	suite.db.Close()
+}
+
+func (suite *ProfileResolverSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Profile created by createUser is exposed to the user
+func (suite *ProfileResolverSuite) TestProfile() {
+	ctx := context.Background()
+
+	req := graphql.NewRequest(`
+		mutation createUser {
+			createUser(input: {email: "profile@example.com", password: "123456", firstName: "shuhei", lastName: "hayashibara", phoneNumber: "03-1234-5678"}) {
+				id
+				token
+			}
+		}
+	`)
+
+	var createResponse map[string]map[string]interface{}
+	suite.NoError(suite.client.Run(ctx, req, &createResponse))
+
+	req = graphql.NewRequest(`
+		query user {
+			user {
+				profile {
+					firstName
+					lastName
+					phoneNumber
+					country
+				}
+			}
+		}
+	`)
+	req.Header.Add("Authorization", "Bearer "+createResponse["createUser"]["token"].(string))
+
+	var res map[string]map[string]map[string]interface{}
+	suite.NoError(suite.client.Run(ctx, req, &res))
+
+	suite.Equal(map[string]interface{}{
+		"firstName":   "shuhei",
+		"lastName":    "hayashibara",
+		"phoneNumber": "03-1234-5678",
+		"country":     nil,
+	}, res["user"]["profile"])
+
+	// other users can't see the profile
+	req = graphql.NewRequest(`
+		query user {
+			user(id: 1) {
+				profile {
+					firstName
+				}
+			}
+		}
+	`)
+
+	var anonymousResponse map[string]map[string]interface{}
+	suite.Error(suite.client.Run(ctx, req, &anonymousResponse))
+}
+
+// Profile is updated with valid inputs
+func (suite *ProfileResolverSuite) TestUpdateProfile() {
+	ctx := context.Background()
+
+	authReq := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "success@simulator.amazonses.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	var authResponse map[string]map[string]interface{}
+	suite.NoError(suite.client.Run(ctx, authReq, &authResponse))
+	token := authResponse["authUser"]["token"].(string)
+
+	// fixture user has no profile yet
+	req := graphql.NewRequest(`
+		mutation updateProfile($input: UpdateProfileInput!) {
+			updateProfile(input: $input) {
+				firstName
+				phoneNumber
+				country
+			}
+		}
+	`)
+	req.Var("input", map[string]interface{}{"firstName": "shuhei", "phoneNumber": "+819012345678", "country": "JP"})
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	var res map[string]map[string]interface{}
+	suite.NoError(suite.client.Run(ctx, req, &res))
+	suite.Equal("shuhei", res["updateProfile"]["firstName"])
+	suite.Equal("+819012345678", res["updateProfile"]["phoneNumber"])
+	suite.Equal("JP", res["updateProfile"]["country"])
+
+	// omitted fields are not changed
+	req.Var("input", map[string]interface{}{"country": "US"})
+	suite.NoError(suite.client.Run(ctx, req, &res))
+	suite.Equal("shuhei", res["updateProfile"]["firstName"])
+	suite.Equal("US", res["updateProfile"]["country"])
+
+	// invalid phone number and country code
+	cases := []struct {
+		input    map[string]interface{}
+		lang     string
+		expected string
+	}{
+		{input: map[string]interface{}{"phoneNumber": "090-1234-5678"}, lang: "en", expected: "E.164"},
+		{input: map[string]interface{}{"phoneNumber": "819012345678"}, lang: "en", expected: "E.164"},
+		{input: map[string]interface{}{"country": "JPN"}, lang: "en", expected: "country code"},
+		{input: map[string]interface{}{"country": "XX"}, lang: "ja", expected: "国コード"},
+	}
+
+	for _, c := range cases {
+		req.Var("input", c.input)
+		req.Header.Set("Accept-Language", c.lang)
+
+		err := suite.client.Run(ctx, req, &res)
+
+		suite.Error(err)
+		suite.Contains(err.Error(), c.expected)
+	}
+
+	// anonymous user
+	req = graphql.NewRequest(`
+		mutation updateProfile {
+			updateProfile(input: {firstName: "shuhei"}) {
+				firstName
+			}
+		}
+	`)
+
+	err := suite.client.Run(ctx, req, &res)
+	suite.Error(err)
+	suite.Contains(err.Error(), "logged in")
+}
+
+func TestProfileResolverSuite(t *testing.T) {
+	suite.Run(t, new(ProfileResolverSuite))
+}
@@ -0,0 +1,272 @@
+package resolver_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-testfixtures/testfixtures"
+
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/ratelimit"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitSuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	fixtures *testfixtures.Context
+}
+
+func (suite *RateLimitSuite) SetupSuite() {
+	// small limit to test without sending many requests
+	os.Setenv("RATE_LIMIT_REQUESTS", "20")
+	os.Setenv("RATE_LIMIT_OPERATION_REQUESTS", "10")
+	// the test server is the proxy sending the addresses of the clients
+	os.Setenv("TRUSTED_PROXIES", "127.0.0.1,::1")
+
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouter(suite.db)
+	suite.ts = httptest.NewServer(m)
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *RateLimitSuite) TearDownSuite() {
+	os.Unsetenv("RATE_LIMIT_REQUESTS")
+	os.Unsetenv("RATE_LIMIT_OPERATION_REQUESTS")
+	os.Unsetenv("TRUSTED_PROXIES")
+	suite.db.Close()
+}
+
+func (suite *RateLimitSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type rateLimitResponse struct {
+	Data   map[string]interface{}   `json:"data"`
+	Errors []map[string]interface{} `json:"errors"`
+}
+
+// post sends the query from the ip address and returns the response
+func (suite *RateLimitSuite) post(query string, ip string, token string) (*http.Response, rateLimitResponse) {
+	header := http.Header{}
+	header.Set("X-Real-IP", ip)
+
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+
+	return suite.send(suite.ts.URL, query, header)
+}
+
+// send sends the query to the server at url with the headers and returns the response
+func (suite *RateLimitSuite) send(url string, query string, header http.Header) (*http.Response, rateLimitResponse) {
+	body, _ := json.Marshal(map[string]interface{}{"query": query})
+	req, _ := http.NewRequest("POST", url+"/query", bytes.NewReader(body))
+	req.Header = header
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+	defer res.Body.Close()
+
+	var payload rateLimitResponse
+	suite.Require().NoError(json.NewDecoder(res.Body).Decode(&payload))
+
+	return res, payload
+}
+
+// Requests are limited by the IP address of anonymous clients and the user id of users
+func (suite *RateLimitSuite) TestRequestLimit() {
+	query := `query { __typename }`
+
+	res, _ := suite.post(query, "10.0.0.1", "")
+	suite.Equal(http.StatusOK, res.StatusCode)
+	suite.Equal("20", res.Header.Get("RateLimit-Limit"))
+	suite.Equal("19", res.Header.Get("RateLimit-Remaining"))
+	suite.Equal("3", res.Header.Get("RateLimit-Reset"))
+
+	for i := 0; i < 19; i++ {
+		res, _ = suite.post(query, "10.0.0.1", "")
+		suite.Require().Equal(http.StatusOK, res.StatusCode)
+	}
+
+	res, payload := suite.post(query, "10.0.0.1", "")
+	suite.Equal(http.StatusTooManyRequests, res.StatusCode)
+	suite.Equal("0", res.Header.Get("RateLimit-Remaining"))
+	suite.Equal("3", res.Header.Get("Retry-After"))
+	suite.Require().Len(payload.Errors, 1)
+	suite.Equal("RATE_LIMITED", payload.Errors[0]["extensions"].(map[string]interface{})["code"])
+	suite.Equal("Too many requests. Please try again later", payload.Errors[0]["message"])
+
+	// other clients are not limited
+	res, _ = suite.post(query, "10.0.0.2", "")
+	suite.Equal(http.StatusOK, res.StatusCode)
+
+	// users are limited apart from the IP address
+	_, payload = suite.post(`
+		mutation {
+			authUser(input: {email: "success@simulator.amazonses.com", password: "123456"}) {
+				token
+			}
+		}
+	`, "10.0.0.2", "")
+	token := payload.Data["authUser"].(map[string]interface{})["token"].(string)
+
+	res, _ = suite.post(query, "10.0.0.1", token)
+	suite.Equal(http.StatusOK, res.StatusCode)
+	suite.Equal("19", res.Header.Get("RateLimit-Remaining"))
+}
+
+// Fields with @rateLimit are limited apart from the requests
+func (suite *RateLimitSuite) TestFieldLimit() {
+	query := `
+		mutation {
+			requestPasswordReset(input: {email: "success@simulator.amazonses.com"}) {
+				status
+			}
+		}
+	`
+
+	for i := 4; i >= 0; i-- {
+		res, payload := suite.post(query, "10.0.1.1", "")
+		suite.Require().Empty(payload.Errors)
+		// the headers of the more restrictive limit are sent
+		suite.Equal("5", res.Header.Get("RateLimit-Limit"))
+		suite.Equal(fmt.Sprint(i), res.Header.Get("RateLimit-Remaining"))
+	}
+
+	res, payload := suite.post(query, "10.0.1.1", "")
+	suite.Equal(http.StatusOK, res.StatusCode)
+	suite.Equal("720", res.Header.Get("Retry-After"))
+	suite.Nil(payload.Data)
+	suite.Require().Len(payload.Errors, 1)
+	suite.Equal("RATE_LIMITED", payload.Errors[0]["extensions"].(map[string]interface{})["code"])
+	suite.Equal([]interface{}{"requestPasswordReset"}, payload.Errors[0]["path"])
+
+	// other fields are not limited
+	res, payload = suite.post(`query { __typename }`, "10.0.1.1", "")
+	suite.Empty(payload.Errors)
+	suite.Equal("20", res.Header.Get("RateLimit-Limit"))
+
+	// other clients are not limited
+	_, payload = suite.post(query, "10.0.1.2", "")
+	suite.Empty(payload.Errors)
+}
+
+// Named operations are limited apart from each other
+func (suite *RateLimitSuite) TestOperationLimit() {
+	for i := 9; i >= 0; i-- {
+		res, payload := suite.post(`query ping { __typename }`, "10.0.2.1", "")
+		suite.Require().Empty(payload.Errors)
+		suite.Equal("10", res.Header.Get("RateLimit-Limit"))
+		suite.Equal(fmt.Sprint(i), res.Header.Get("RateLimit-Remaining"))
+	}
+
+	res, payload := suite.post(`query ping { __typename }`, "10.0.2.1", "")
+	suite.Equal(http.StatusOK, res.StatusCode)
+	suite.Equal("6", res.Header.Get("Retry-After"))
+	suite.Require().Len(payload.Errors, 1)
+	suite.Equal("RATE_LIMITED", payload.Errors[0]["extensions"].(map[string]interface{})["code"])
+
+	// other operations are not limited
+	_, payload = suite.post(`query pong { __typename }`, "10.0.2.1", "")
+	suite.Empty(payload.Errors)
+
+	// operations without names are limited only by the requests
+	res, payload = suite.post(`query { __typename }`, "10.0.2.1", "")
+	suite.Empty(payload.Errors)
+	suite.Equal("20", res.Header.Get("RateLimit-Limit"))
+}
+
+// Addresses forged by clients in X-Forwarded-For don't reset the limit
+func (suite *RateLimitSuite) TestForgedForwardedFor() {
+	query := `query { __typename }`
+
+	// the proxy appends the address of the client to the addresses given by the client
+	for i := 0; i < 20; i++ {
+		res, _ := suite.send(suite.ts.URL, query, http.Header{"X-Forwarded-For": {fmt.Sprintf("198.51.100.%d, 10.0.3.1", i)}})
+		suite.Require().Equal(http.StatusOK, res.StatusCode)
+	}
+
+	res, _ := suite.send(suite.ts.URL, query, http.Header{"X-Forwarded-For": {"198.51.100.99, 10.0.3.1"}})
+	suite.Equal(http.StatusTooManyRequests, res.StatusCode)
+
+	// the headers are ignored from the clients which are not the trusted proxies
+	os.Unsetenv("TRUSTED_PROXIES")
+	ts := httptest.NewServer(testutils.PrepareRouter(suite.db))
+	os.Setenv("TRUSTED_PROXIES", "127.0.0.1,::1")
+	defer ts.Close()
+
+	for i := 0; i < 20; i++ {
+		res, _ := suite.send(ts.URL, query, http.Header{"X-Forwarded-For": {fmt.Sprintf("198.51.100.%d", i)}, "X-Real-Ip": {fmt.Sprintf("198.51.101.%d", i)}})
+		suite.Require().Equal(http.StatusOK, res.StatusCode)
+	}
+
+	res, _ = suite.send(ts.URL, query, http.Header{"X-Forwarded-For": {"198.51.100.99"}})
+	suite.Equal(http.StatusTooManyRequests, res.StatusCode)
+}
+
+// Buckets are refilled at the rate of the limit per window
+func (suite *RateLimitSuite) TestStores() {
+	ctx := context.Background()
+	rule := ratelimit.Rule{Limit: 2, Window: time.Minute}
+
+	for name, newStore := range map[string]func(clock.Clock) ratelimit.Store{
+		"memory": func(c clock.Clock) ratelimit.Store { return ratelimit.NewMemoryStore(c) },
+		"redis": func(c clock.Clock) ratelimit.Store {
+			return ratelimit.NewRedisStore(os.Getenv("REDIS_HOST")+":6379", c)
+		},
+	} {
+		clk := clock.NewMock(time.Now())
+		store := newStore(clk)
+		// keys are unique so that redis keeps no bucket of the previous runs
+		key := fmt.Sprintf("test:%d", time.Now().UnixNano())
+
+		res, err := store.Take(ctx, key, rule)
+		suite.Require().NoError(err, name)
+		suite.Equal(ratelimit.Result{Allowed: true, Limit: 2, Remaining: 1, Reset: 30 * time.Second}, res, name)
+
+		res, err = store.Take(ctx, key, rule)
+		suite.Require().NoError(err, name)
+		suite.Equal(ratelimit.Result{Allowed: true, Limit: 2, Remaining: 0, Reset: time.Minute}, res, name)
+
+		res, err = store.Take(ctx, key, rule)
+		suite.Require().NoError(err, name)
+		suite.Equal(ratelimit.Result{Allowed: false, Limit: 2, Remaining: 0, Reset: time.Minute, RetryAfter: 30 * time.Second}, res, name)
+
+		clk.Add(45 * time.Second)
+
+		res, err = store.Take(ctx, key, rule)
+		suite.Require().NoError(err, name)
+		suite.Equal(ratelimit.Result{Allowed: true, Limit: 2, Remaining: 0, Reset: 45 * time.Second}, res, name)
+
+		// the bucket is full after the window
+		clk.Add(time.Minute)
+
+		res, err = store.Take(ctx, key, rule)
+		suite.Require().NoError(err, name)
+		suite.Equal(1, res.Remaining, name)
+	}
+}
+
+func TestRateLimitSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitSuite))
+}
@@ -3,12 +3,94 @@
 package resolver
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shufo/go-graphql-boilerplate/blob"
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/configs"
 	"github.com/shufo/go-graphql-boilerplate/graph/generated"
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/notification"
+	"github.com/shufo/go-graphql-boilerplate/pubsub"
+	"github.com/shufo/go-graphql-boilerplate/ratelimit"
+	"github.com/sirupsen/logrus"
 )
 
 // THIS CODE IS A STARTING POINT ONLY. IT WILL NOT BE UPDATED WITH SCHEMA CHANGES.
 
+// Resolver holds the dependencies shared by every resolver.
+// It is constructed once in server.Router and can be built with fakes in tests.
 type Resolver struct {
+	DB       *sql.DB
+	Enforcer Enforcer
+	// Mailer is called in database.Transaction, so it must join the transaction
+	// in the context like mailqueue.Queue instead of sending mails immediately
+	Mailer    mail.Mailer
+	MailQueue MailQueue
+	// MailTemplates renders the mails sent by Mailer
+	MailTemplates MailTemplates
+	Notifier      Notifier
+	Clock         clock.Clock
+	Config        configs.Config
+	Logger        logrus.FieldLogger
+	PubSub        pubsub.PubSub
+	Storage       blob.Storage
+	RateLimiter   ratelimit.Store
+}
+
+// Enforcer decides whether the subject is allowed to do the action on the resource like *casbin.CachedEnforcer
+type Enforcer interface {
+	Enforce(rvals ...interface{}) bool
+}
+
+// MailQueue is the queue of the mails like *mailqueue.Queue
+type MailQueue interface {
+	Retry(ctx context.Context, id int) (*models.MailMessage, error)
+}
+
+// MailTemplates renders the mails like *mail.Templates
+type MailTemplates interface {
+	NewMail(ctx context.Context, to string, name string, data interface{}) (*mail.Mail, error)
+}
+
+// Notifier mails the notifications to the users who enabled them like *notification.Notifier
+type Notifier interface {
+	Mail(ctx context.Context, u *models.User, category notification.Category, name string, data interface{}) (bool, error)
+}
+
+// Validate returns the error listing the dependencies which are not set
+func (r *Resolver) Validate() error {
+	missing := []string{}
+
+	for name, ok := range map[string]bool{
+		"DB":            r.DB != nil,
+		"Enforcer":      r.Enforcer != nil,
+		"Mailer":        r.Mailer != nil,
+		"MailQueue":     r.MailQueue != nil,
+		"MailTemplates": r.MailTemplates != nil,
+		"Notifier":      r.Notifier != nil,
+		"Clock":         r.Clock != nil,
+		"Logger":        r.Logger != nil,
+		"PubSub":        r.PubSub != nil,
+		"Storage":       r.Storage != nil,
+		"RateLimiter":   r.RateLimiter != nil,
+	} {
+		if !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("resolver dependencies are missing: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
 }
 
 func (r *Resolver) Mutation() generated.MutationResolver {
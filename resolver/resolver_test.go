@@ -0,0 +1,52 @@
+package resolver_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/resolver"
+	"github.com/stretchr/testify/suite"
+	"github.com/vektah/gqlparser"
+	"github.com/vektah/gqlparser/ast"
+)
+
+type ResolverSuite struct {
+	suite.Suite
+}
+
+// Missing dependencies are reported at once
+func (suite *ResolverSuite) TestValidate() {
+	r := &resolver.Resolver{DB: &sql.DB{}, Clock: clock.Real{}}
+
+	err := r.Validate()
+
+	suite.Error(err)
+	suite.Equal("resolver dependencies are missing: Enforcer, Logger, MailQueue, MailTemplates, Mailer, Notifier, PubSub, RateLimiter, Storage", err.Error())
+}
+
+// Invalid regexes of @pattern are reported before serving requests
+func (suite *ResolverSuite) TestValidationMiddlewarePattern() {
+	schema, gqlErr := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: `
+		directive @pattern(regex: String!) on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+
+		input UserInput {
+			code: String! @pattern(regex: "^[0-9]+$")
+			name: String! @pattern(regex: "[a-z")
+		}
+
+		type Query {
+			user(input: UserInput!): String
+		}
+	`})
+	suite.Require().Nil(gqlErr)
+
+	_, err := resolver.ValidationMiddleware(schema)
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "invalid @pattern on UserInput.name")
+}
+
+func TestResolverSuite(t *testing.T) {
+	suite.Run(t, new(ResolverSuite))
+}
@@ -0,0 +1,145 @@
+package resolver_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/machinebox/graphql"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type RoleSuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	client   *graphql.Client
+	fixtures *testfixtures.Context
+}
+
+func (suite *RoleSuite) SetupSuite() {
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouter(suite.db)
+	suite.ts = httptest.NewServer(m)
+	suite.client = graphql.NewClient(suite.ts.URL + "/query")
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *RoleSuite) TearDownSuite() {
+	suite.db.Close()
+}
+
+func (suite *RoleSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Roles are ranked from USER to SUPER_ADMIN
+func (suite *RoleSuite) TestRoleRank() {
+	ctx := context.Background()
+	ranks := []int{}
+
+	for _, role := range []models.RoleType{
+		models.RoleTypeUser,
+		models.RoleTypeResourceOwner,
+		models.RoleTypeOrganizationMember,
+		models.RoleTypeOrganizationAdmin,
+		models.RoleTypeSuperAdmin,
+	} {
+		rank, err := models.RoleRank(ctx, suite.db, role)
+		suite.Require().NoError(err)
+		ranks = append(ranks, rank)
+	}
+
+	suite.Equal([]int{10, 20, 30, 40, 50}, ranks)
+
+	// unknown roles are reported with the cause
+	_, err := models.RoleRank(ctx, suite.db, models.RoleType("UNKNOWN"))
+	suite.Require().Error(err)
+	suite.Equal(sql.ErrNoRows, errors.Cause(err))
+	suite.Contains(err.Error(), "role UNKNOWN is not defined")
+}
+
+// Roles granted within an organization apply only to the organization
+func (suite *RoleSuite) TestOrganizationRoles() {
+	ctx := context.Background()
+	organizationID, otherOrganizationID := 10, 11
+
+	ur := &models.UserRole{UserID: 1, RoleID: 3, OrganizationID: null.IntFrom(organizationID)}
+	suite.Require().NoError(ur.Insert(ctx, suite.db, boil.Infer()))
+
+	rank, err := models.UserMaxRoleRank(ctx, suite.db, 1, nil)
+	suite.Require().NoError(err)
+	suite.Equal(10, rank)
+
+	rank, err = models.UserMaxRoleRank(ctx, suite.db, 1, &organizationID)
+	suite.Require().NoError(err)
+	suite.Equal(40, rank)
+
+	rank, err = models.UserMaxRoleRank(ctx, suite.db, 1, &otherOrganizationID)
+	suite.Require().NoError(err)
+	suite.Equal(10, rank)
+
+	roles, err := models.UserRoleTypes(ctx, suite.db, 1)
+	suite.Require().NoError(err)
+	suite.Equal([]models.RoleType{models.RoleTypeUser}, roles)
+
+	// the token holds only the global roles
+	req := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "success@simulator.amazonses.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	var res map[string]map[string]interface{}
+	suite.Require().NoError(suite.client.Run(ctx, req, &res))
+
+	token := res["authUser"]["token"].(string)
+	payload, err := base64.RawURLEncoding.DecodeString(strings.Split(token, ".")[1])
+	suite.Require().NoError(err)
+
+	var claims map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(payload, &claims))
+	suite.Equal([]interface{}{"USER"}, claims["roles"])
+
+	// organization admins can't see the resources of other users
+	req = graphql.NewRequest(`
+		query user {
+			user(id: 2) {
+				notificationPreferences {
+					enabled
+				}
+			}
+		}
+	`)
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	var other map[string]interface{}
+	err = suite.client.Run(ctx, req, &other)
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "You are not granted to access this resource")
+}
+
+func TestRoleSuite(t *testing.T) {
+	suite.Run(t, new(RoleSuite))
+}
@@ -0,0 +1,187 @@
+package resolver_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/machinebox/graphql"
+
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/shufo/go-graphql-boilerplate/mailqueue"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/shufo/go-graphql-boilerplate/server"
+	"github.com/shufo/go-graphql-boilerplate/sns/snstest"
+	"github.com/shufo/go-graphql-boilerplate/suppression"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+)
+
+type SESNotificationSuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	client   *graphql.Client
+	fixtures *testfixtures.Context
+	stub     *snstest.Stub
+}
+
+func (suite *SESNotificationSuite) SetupSuite() {
+	stub, err := snstest.NewStub()
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.stub = stub
+
+	// accept the notifications from the topic of the stub
+	os.Setenv("SES_WEBHOOK_ENABLED", "true")
+	os.Setenv("SES_SNS_TOPIC_ARNS", snstest.TopicArn)
+	defer os.Unsetenv("SES_WEBHOOK_ENABLED")
+	defer os.Unsetenv("SES_SNS_TOPIC_ARNS")
+
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouterWithConfig(suite.db, server.Config{SNSCertificates: stub})
+	suite.ts = httptest.NewServer(m)
+	suite.client = graphql.NewClient(suite.ts.URL + "/query")
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *SESNotificationSuite) TearDownSuite() {
+	suite.db.Close()
+}
+
+func (suite *SESNotificationSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (suite *SESNotificationSuite) post(body []byte) int {
+	res, err := http.Post(suite.ts.URL+"/webhooks/ses", "text/plain; charset=UTF-8", bytes.NewReader(body))
+	suite.Require().NoError(err)
+	res.Body.Close()
+
+	return res.StatusCode
+}
+
+func (suite *SESNotificationSuite) suppressed(email string) bool {
+	ok, err := suppression.IsSuppressed(context.Background(), suite.db, email)
+	suite.Require().NoError(err)
+
+	return ok
+}
+
+// Hard bounces and complaints are suppressed
+func (suite *SESNotificationSuite) TestSuppress() {
+	suite.Equal(http.StatusOK, suite.post(suite.stub.Bounce("Permanent", "bounce@simulator.amazonses.com")))
+	suite.Equal(http.StatusOK, suite.post(suite.stub.Bounce("Transient", "ooto@simulator.amazonses.com")))
+	suite.Equal(http.StatusOK, suite.post(suite.stub.Complaint("Complaint@simulator.amazonses.com")))
+
+	suite.True(suite.suppressed("bounce@simulator.amazonses.com"))
+	suite.False(suite.suppressed("ooto@simulator.amazonses.com"))
+	suite.True(suite.suppressed("complaint@simulator.amazonses.com"))
+
+	s, err := models.MailSuppressions(models.MailSuppressionWhere.Email.EQ("bounce@simulator.amazonses.com")).One(context.Background(), suite.db)
+	suite.Require().NoError(err)
+	suite.Equal(suppression.ReasonBounce, s.Reason)
+	suite.Equal("Permanent/General", s.Detail.String)
+}
+
+// Messages not signed by SNS are rejected
+func (suite *SESNotificationSuite) TestRejectForgedMessage() {
+	msg := map[string]interface{}{}
+	suite.Require().NoError(json.Unmarshal(suite.stub.Bounce("Permanent", "bounce@simulator.amazonses.com"), &msg))
+
+	// the recipient is replaced after signing
+	msg["Message"] = `{"notificationType":"Bounce","bounce":{"bounceType":"Permanent","bouncedRecipients":[{"emailAddress":"success@simulator.amazonses.com"}]}}`
+	forged, _ := json.Marshal(msg)
+
+	suite.Equal(http.StatusForbidden, suite.post(forged))
+
+	// the certificate is not of SNS
+	msg["SigningCertURL"] = "https://example.com/cert.pem"
+	forged, _ = json.Marshal(msg)
+
+	suite.Equal(http.StatusForbidden, suite.post(forged))
+
+	suite.False(suite.suppressed("success@simulator.amazonses.com"))
+	suite.False(suite.suppressed("bounce@simulator.amazonses.com"))
+
+	// the message is signed by SNS but sent from other topic
+	other := suite.stub.Notification("arn:aws:sns:us-east-1:123456789012:other", `{"notificationType":"Complaint","complaint":{"complainedRecipients":[{"emailAddress":"success@simulator.amazonses.com"}]}}`)
+
+	suite.Equal(http.StatusForbidden, suite.post(other))
+	suite.False(suite.suppressed("success@simulator.amazonses.com"))
+}
+
+// The webhook is not mounted unless it is enabled
+func (suite *SESNotificationSuite) TestWebhookDisabled() {
+	ts := httptest.NewServer(testutils.PrepareRouterWithConfig(suite.db, server.Config{SNSCertificates: suite.stub}))
+	defer ts.Close()
+
+	res, err := http.Post(ts.URL+"/webhooks/ses", "text/plain; charset=UTF-8", bytes.NewReader(suite.stub.Complaint("success@simulator.amazonses.com")))
+	suite.Require().NoError(err)
+	res.Body.Close()
+
+	suite.Equal(http.StatusNotFound, res.StatusCode)
+	suite.False(suite.suppressed("success@simulator.amazonses.com"))
+}
+
+// Mails are not sent to the suppressed addresses
+func (suite *SESNotificationSuite) TestRefuseSuppressed() {
+	ctx := context.Background()
+
+	suite.Equal(http.StatusOK, suite.post(suite.stub.Complaint("success@simulator.amazonses.com")))
+
+	req := graphql.NewRequest(`
+		mutation requestPasswordReset {
+			requestPasswordReset(input: {email: "success@simulator.amazonses.com"}) {
+				status
+			}
+		}
+	`)
+
+	var res map[string]map[string]interface{}
+	err := suite.client.Run(ctx, req, &res)
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "can not be delivered")
+
+	// the queued mail is failed without retries on delivery
+	outbox := mail.NewOutbox("")
+	q := mailqueue.New(suite.db, suppression.NewMailer(suite.db, outbox), clock.Real{}, logrus.New(), configs.Default().MailQueue)
+
+	m := mail.New("success@simulator.amazonses.com")
+	m.SetSubject("queued before complaint")
+	m.SetHTMLBody("<p>body</p>")
+	m.SetTextBody("body")
+	suite.Require().NoError(q.Send(ctx, m))
+
+	_, err = q.ProcessDue(ctx)
+	suite.Require().NoError(err)
+
+	msg, err := models.MailMessages(models.MailMessageWhere.Subject.EQ("queued before complaint")).One(ctx, suite.db)
+	suite.Require().NoError(err)
+	suite.Equal(mailqueue.StatusFailed, msg.Status)
+	suite.Equal(1, msg.Attempts)
+	suite.Empty(outbox.Mails())
+}
+
+func TestSESNotificationSuite(t *testing.T) {
+	suite.Run(t, new(SESNotificationSuite))
+}
@@ -0,0 +1,109 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/auth"
+	"github.com/shufo/go-graphql-boilerplate/graph/generated"
+	"github.com/shufo/go-graphql-boilerplate/models"
+)
+
+type subscriptionResolver struct{ *Resolver }
+
+func (r *Resolver) Subscription() generated.SubscriptionResolver {
+	return &subscriptionResolver{r}
+}
+
+func (r *subscriptionResolver) SessionRevoked(ctx context.Context) (<-chan *models.SessionRevokedEvent, error) {
+	userID, ok := auth.UserIDForContext(ctx)
+
+	if !ok {
+		return nil, apperror.Unauthenticated(ctx)
+	}
+
+	messages, err := r.PubSub.Subscribe(ctx, sessionRevokedTopic(userID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *models.SessionRevokedEvent)
+
+	go func() {
+		defer close(ch)
+
+		for m := range messages {
+			e := &models.SessionRevokedEvent{}
+
+			if err := json.Unmarshal(m, e); err != nil {
+				continue
+			}
+
+			select {
+			case ch <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func (r *subscriptionResolver) PasswordChanged(ctx context.Context) (<-chan *models.PasswordChangedEvent, error) {
+	userID, ok := auth.UserIDForContext(ctx)
+
+	if !ok {
+		return nil, apperror.Unauthenticated(ctx)
+	}
+
+	messages, err := r.PubSub.Subscribe(ctx, passwordChangedTopic(userID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *models.PasswordChangedEvent)
+
+	go func() {
+		defer close(ch)
+
+		for m := range messages {
+			e := &models.PasswordChangedEvent{}
+
+			if err := json.Unmarshal(m, e); err != nil {
+				continue
+			}
+
+			select {
+			case ch <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func sessionRevokedTopic(userID int) string {
+	return fmt.Sprintf("user:%d:session_revoked", userID)
+}
+
+func passwordChangedTopic(userID int) string {
+	return fmt.Sprintf("user:%d:password_changed", userID)
+}
+
+// publish sends the event to subscribers of the topic
+func (r *Resolver) publish(ctx context.Context, topic string, event interface{}) error {
+	b, err := json.Marshal(event)
+
+	if err != nil {
+		return err
+	}
+
+	return r.PubSub.Publish(ctx, topic, b)
+}
@@ -0,0 +1,140 @@
+package resolver_test
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-testfixtures/testfixtures"
+	"github.com/gorilla/websocket"
+	"github.com/machinebox/graphql"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/shufo/go-graphql-boilerplate/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type SubscriptionResolverSuite struct {
+	suite.Suite
+	db       *sql.DB
+	ts       *httptest.Server
+	client   *graphql.Client
+	fixtures *testfixtures.Context
+}
+
+type operationMessage struct {
+	Payload map[string]interface{} `json:"payload,omitempty"`
+	ID      string                 `json:"id,omitempty"`
+	Type    string                 `json:"type"`
+}
+
+func (suite *SubscriptionResolverSuite) SetupSuite() {
+	suite.db = testutils.PrepareDB()
+	m := testutils.PrepareRouter(suite.db)
+	suite.ts = httptest.NewServer(m)
+	suite.client = graphql.NewClient(suite.ts.URL + "/query")
+
+	fixtures, err := testfixtures.NewFolder(suite.db, &testfixtures.MySQL{}, "../fixtures")
+	if err != nil {
+		log.Fatal(err)
+	}
+	suite.fixtures = fixtures
+}
+
+func (suite *SubscriptionResolverSuite) TearDownSuite() {
+	suite.db.Close()
+}
+
+func (suite *SubscriptionResolverSuite) SetupTest() {
+	if err := suite.fixtures.Load(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// subscribe opens websocket connection with the token and starts the subscription
+func (suite *SubscriptionResolverSuite) subscribe(token string, query string) *websocket.Conn {
+	dialer := websocket.Dialer{Subprotocols: []string{"graphql-ws"}}
+	conn, _, err := dialer.Dial(strings.Replace(suite.ts.URL, "http", "ws", 1)+"/query", nil)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(conn.WriteJSON(operationMessage{
+		Type:    "connection_init",
+		Payload: map[string]interface{}{"Authorization": "Bearer " + token},
+	}))
+
+	var ack operationMessage
+	suite.Require().NoError(conn.ReadJSON(&ack))
+	suite.Require().Equal("connection_ack", ack.Type)
+
+	suite.Require().NoError(conn.WriteJSON(operationMessage{
+		ID:      "1",
+		Type:    "start",
+		Payload: map[string]interface{}{"query": query},
+	}))
+
+	return conn
+}
+
+func (suite *SubscriptionResolverSuite) authenticate(token string) string {
+	req := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "success@simulator.amazonses.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	if token != "" {
+		req.Header.Add("Authorization", "Bearer "+token)
+	}
+
+	var res map[string]map[string]interface{}
+	suite.Require().NoError(suite.client.Run(context.Background(), req, &res))
+
+	return res["authUser"]["token"].(string)
+}
+
+// Revoked session is notified to the user
+func (suite *SubscriptionResolverSuite) TestSessionRevoked() {
+	token := suite.authenticate("")
+
+	conn := suite.subscribe(token, `subscription { sessionRevoked { userId revokedAt } }`)
+	defer conn.Close()
+
+	// wait for the subscription to start
+	time.Sleep(100 * time.Millisecond)
+
+	// reauthenticate to revoke the previous token
+	suite.authenticate(token)
+
+	conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+
+	var msg operationMessage
+	suite.NoError(conn.ReadJSON(&msg))
+	suite.Equal("data", msg.Type)
+
+	event := msg.Payload["data"].(map[string]interface{})["sessionRevoked"].(map[string]interface{})
+	suite.Equal(float64(1), event["userId"])
+	suite.NotEmpty(event["revokedAt"])
+}
+
+// Anonymous user can't subscribe
+func (suite *SubscriptionResolverSuite) TestSubscribeWithoutToken() {
+	conn := suite.subscribe("", `subscription { passwordChanged { userId } }`)
+	defer conn.Close()
+
+	conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+
+	var msg operationMessage
+	suite.NoError(conn.ReadJSON(&msg))
+	suite.Equal("data", msg.Type)
+	suite.Equal("UNAUTHENTICATED", msg.Payload["errors"].([]interface{})[0].(map[string]interface{})["extensions"].(map[string]interface{})["code"])
+}
+
+func TestSubscriptionResolverSuite(t *testing.T) {
+	suite.Run(t, new(SubscriptionResolverSuite))
+}
@@ -3,25 +3,23 @@ package resolver
 import (
 	"context"
 	"database/sql"
-	"fmt"
-	"os"
-	"time"
+	"strings"
 
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/audit"
+	"github.com/shufo/go-graphql-boilerplate/database"
+	"github.com/shufo/go-graphql-boilerplate/dataloader"
 	"github.com/shufo/go-graphql-boilerplate/graph/generated"
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/shufo/go-graphql-boilerplate/pagination"
 	"github.com/shufo/go-graphql-boilerplate/utils"
 
-	"github.com/shufo/go-graphql-boilerplate/translations"
-
-	"github.com/shufo/go-graphql-boilerplate/configs"
-
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/volatiletech/null"
 
 	"github.com/volatiletech/sqlboiler/queries/qm"
 
-	"github.com/99designs/gqlgen/graphql"
-
 	"github.com/go-chi/jwtauth"
 
 	"github.com/dgrijalva/jwt-go"
@@ -29,7 +27,8 @@ import (
 	"github.com/volatiletech/sqlboiler/boil"
 )
 
-var tokenAuth *jwtauth.JWTAuth
+// likeEscaper escapes wildcard characters of LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
 
 type userResolver struct{ *Resolver }
 
@@ -38,116 +37,158 @@ func (r *Resolver) User() generated.UserResolver {
 }
 
 func (r *mutationResolver) CreateUser(ctx context.Context, input models.CreateUserInput) (*models.AuthenticatedUser, error) {
-	db := ctx.Value("db").(*sql.DB)
+	hashed, _ := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.MinCost)
 
-	if err := input.Validate(ctx); err != nil {
-		for k, v := range err {
-			graphql.AddErrorf(ctx, "%s: %s", k, v)
+	var u *models.User
+	var token string
+
+	// registered is set if the email is already registered in privacy mode
+	registered := false
+
+	// all records are created or none of them are
+	err := database.Transaction(ctx, r.DB, func(ctx context.Context, tx *sql.Tx) error {
+		// check if user is already exists
+		if exists, _ := models.AuthenticationProviders(
+			qm.Where("provider_type = ?", "email"),
+			qm.Where("provider_username = ?", input.Email),
+		).Exists(ctx, tx); exists {
+			if r.Config.PrivacyMode {
+				registered = true
+				return nil
+			}
+
+			return apperror.New(ctx, apperror.CodeConflict, "email_already_exists")
 		}
-		return nil, nil
-	}
 
-	// check if user is already exists
-	if exists, _ := models.AuthenticationProviders(
-		qm.Where("provider_type = ?", "email"),
-		qm.Where("provider_username = ?", input.Email),
-	).Exists(ctx, db); exists {
-		return nil, fmt.Errorf(translations.T(ctx, "email_already_exists"))
-	}
+		// create User record
+		u = &models.User{Username: null.String{String: input.Email, Valid: true}}
 
-	// create User record
-	u := &models.User{Username: null.String{String: input.Email, Valid: true}}
+		if err := u.Validate(); err != nil {
+			return err
+		}
 
-	if err := u.Validate(); err != nil {
-		return nil, err
-	}
+		if err := u.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
 
-	if err := u.Insert(ctx, db, boil.Infer()); err != nil {
-		return nil, err
-	}
+		// create auth provider record
+		ap := models.AuthenticationProvider{
+			ProviderType:     "email",
+			ProviderUsername: input.Email,
+			ProviderPassword: string(hashed),
+			Email:            null.StringFrom(input.Email),
+			FirstName:        null.StringFrom(input.FirstName),
+			LastName:         null.StringFrom(input.LastName),
+		}
 
-	hashed, _ := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.MinCost)
+		if err := ap.SetUser(ctx, tx, false, u); err != nil {
+			return err
+		}
 
-	// create auth provider record
-	ap := models.AuthenticationProvider{
-		ProviderType:     "email",
-		ProviderUsername: input.Email,
-		ProviderPassword: string(hashed),
-		Email:            null.StringFrom(input.Email),
-		FirstName:        null.StringFrom(input.FirstName),
-		LastName:         null.StringFrom(input.LastName),
-	}
+		if err := ap.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
 
-	if err := ap.SetUser(ctx, db, false, u); err != nil {
-		return nil, err
-	}
+		// create a profile for user
+		pr := models.Profile{
+			FirstName:   null.StringFrom(input.FirstName),
+			LastName:    null.StringFrom(input.LastName),
+			PhoneNumber: null.StringFrom(input.PhoneNumber),
+		}
 
-	if err := ap.Insert(ctx, db, boil.Infer()); err != nil {
-		return nil, err
-	}
+		if err := pr.SetUser(ctx, tx, false, u); err != nil {
+			return err
+		}
 
-	// create a profile for user
-	pr := models.Profile{
-		FirstName:   null.StringFrom(input.FirstName),
-		LastName:    null.StringFrom(input.LastName),
-		PhoneNumber: null.StringFrom(input.PhoneNumber),
-	}
+		if err := pr.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
 
-	if err := pr.SetUser(ctx, db, false, u); err != nil {
-		return nil, err
-	}
+		// set roles
+		role, err := models.Roles(qm.Where("type = ?", "USER")).One(ctx, tx)
 
-	if err := pr.Insert(ctx, db, boil.Infer()); err != nil {
-		return nil, err
-	}
+		if err != nil {
+			return err
+		}
 
-	// set roles
-	role, _ := models.Roles(qm.Where("type = ?", "USER")).One(ctx, db)
+		ur := &models.UserRole{}
 
-	ur := &models.UserRole{}
-	ur.SetUser(ctx, db, false, u)
-	ur.SetRole(ctx, db, false, role)
+		if err := ur.SetUser(ctx, tx, false, u); err != nil {
+			return err
+		}
 
-	if err := ur.Insert(ctx, db, boil.Infer()); err != nil {
-		return nil, err
-	}
+		if err := ur.SetRole(ctx, tx, false, role); err != nil {
+			return err
+		}
+
+		if err := ur.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+
+		// record audit events
+		if err := audit.Record(ctx, tx, audit.Event{
+			Action:     audit.ActionUserCreated,
+			ActorID:    &u.ID,
+			TargetType: audit.TargetUser,
+			TargetID:   &u.ID,
+		}); err != nil {
+			return err
+		}
+
+		if err := audit.Record(ctx, tx, audit.Event{
+			Action:     audit.ActionRoleGranted,
+			ActorID:    &u.ID,
+			TargetType: audit.TargetUser,
+			TargetID:   &u.ID,
+			Diff:       audit.Diff(nil, map[string]interface{}{"role": role.Type}),
+		}); err != nil {
+			return err
+		}
+
+		// the new user is not signed in as the response must be the same as the registered email's
+		if r.Config.PrivacyMode {
+			return nil
+		}
+
+		// create token
+		token, err = r.createToken(ctx, tx, u)
 
-	// create token
-	token, err := createToken(u)
+		if err != nil {
+			return err
+		}
+
+		// add token to auth token table for revocation
+		at := &models.AuthToken{
+			UserID:    u.ID,
+			Token:     token,
+			ExpiresAt: r.Clock.Now().Add(r.Config.TokenLifetime),
+		}
+
+		return at.Insert(ctx, tx, boil.Infer())
+	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	// add token to auth token table for revocation
-	at := &models.AuthToken{
-		UserID:    u.ID,
-		Token:     token,
-		ExpiresAt: time.Now().Add(configs.TokenLifetime),
-	}
+	if r.Config.PrivacyMode {
+		if registered {
+			r.notifyEmailOwner(ctx, input.Email, "signup_attempt")
+		}
 
-	if err := at.Insert(ctx, db, boil.Infer()); err != nil {
-		return nil, err
+		return &models.AuthenticatedUser{}, nil
 	}
 
 	res := &models.AuthenticatedUser{
-		ID:    u.ID,
-		Token: token,
+		ID:    &u.ID,
+		Token: &token,
 	}
 
 	return res, nil
 }
 
 func (r *mutationResolver) AuthUser(ctx context.Context, input models.AuthUserInput) (*models.AuthenticatedUser, error) {
-	db := ctx.Value("db").(*sql.DB)
-
-	// validate inputs
-	if err := input.Validate(ctx); err != nil {
-		for k, v := range err {
-			graphql.AddErrorf(ctx, "%s: %s", k, v)
-		}
-		return nil, nil
-	}
+	db := r.DB
 
 	// search user if it exists
 	ap, err := models.AuthenticationProviders(
@@ -157,57 +198,133 @@ func (r *mutationResolver) AuthUser(ctx context.Context, input models.AuthUserIn
 	).One(ctx, db)
 
 	if err != nil {
-		return nil, fmt.Errorf(translations.T(ctx, "email_or_password_is_incorrect"))
+		// keep the attempted email to trace the attacks on the accounts which don't exist
+		r.recordAudit(ctx, db, audit.Event{
+			Action:     audit.ActionLoginFailed,
+			TargetType: audit.TargetUser,
+			Diff:       audit.Diff(nil, map[string]interface{}{"email": input.Email}),
+		})
+		return nil, apperror.New(ctx, apperror.CodeUnauthenticated, "email_or_password_is_incorrect")
 	}
 
 	// compare hashed password with inputed password
 	if err := bcrypt.CompareHashAndPassword([]byte(ap.ProviderPassword), []byte(input.Password)); err != nil {
-		return nil, fmt.Errorf(translations.T(ctx, "email_or_password_is_incorrect"))
+		r.recordAudit(ctx, db, audit.Event{
+			Action:     audit.ActionLoginFailed,
+			TargetType: audit.TargetUser,
+			TargetID:   &ap.UserID,
+		})
+		return nil, apperror.New(ctx, apperror.CodeUnauthenticated, "email_or_password_is_incorrect")
 	}
 
 	// swipe already exists auth token if token exists in header
-	if ht, ok := ctx.Value(jwtauth.TokenCtxKey).(jwt.Token); ok {
-		if _, err := models.AuthTokens(
+	if ht, ok := ctx.Value(jwtauth.TokenCtxKey).(*jwt.Token); ok && ht != nil {
+		deleted, err := models.AuthTokens(
 			qm.Where("user_id = ?", ap.UserID),
 			qm.Where("token = ?", ht.Raw),
-		).DeleteAll(ctx, db); err != nil {
+		).DeleteAll(ctx, db)
+
+		if err != nil {
 			return nil, err
 		}
+
+		if deleted > 0 {
+			r.recordAudit(ctx, db, audit.Event{
+				Action:     audit.ActionTokenRevoked,
+				ActorID:    &ap.UserID,
+				TargetType: audit.TargetUser,
+				TargetID:   &ap.UserID,
+			})
+
+			if err := r.publish(ctx, sessionRevokedTopic(ap.UserID), &models.SessionRevokedEvent{
+				UserID:    ap.UserID,
+				RevokedAt: r.Clock.Now(),
+			}); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// create new token
-	token, err := createToken(ap.R.User)
+	token, err := r.createToken(ctx, db, ap.R.User)
 
 	if err != nil {
 		return nil, err
 	}
 
+	// add token to auth token table for revocation
+	at := &models.AuthToken{
+		UserID:    ap.UserID,
+		Token:     token,
+		ExpiresAt: r.Clock.Now().Add(r.Config.TokenLifetime),
+	}
+
+	if err := at.Insert(ctx, db, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	r.recordAudit(ctx, db, audit.Event{
+		Action:     audit.ActionLoginSucceeded,
+		ActorID:    &ap.UserID,
+		TargetType: audit.TargetUser,
+		TargetID:   &ap.UserID,
+	})
+
 	res := &models.AuthenticatedUser{
-		ID:    ap.UserID,
-		Token: token,
+		ID:    &ap.UserID,
+		Token: &token,
 	}
 
 	return res, nil
 }
 
-func createToken(u *models.User) (string, error) {
+// recordAudit records the event outside of transactions.
+// Errors are only logged so that the audit log being unavailable doesn't lock the users out.
+func (r *Resolver) recordAudit(ctx context.Context, exec boil.ContextExecutor, e audit.Event) {
+	if err := audit.Record(ctx, exec, e); err != nil {
+		r.Logger.WithError(err).WithField("action", e.Action).Error("failed to record audit event")
+	}
+}
+
+// notifyEmailOwner mails the owner of the email about the request made with it in privacy mode.
+// Errors are only logged as the response must not differ whether the mail is sent or not.
+func (r *Resolver) notifyEmailOwner(ctx context.Context, email string, name string) {
+	m, err := r.MailTemplates.NewMail(ctx, email, name, map[string]interface{}{
+		"Email": email,
+	})
+
+	if err == nil {
+		err = r.Mailer.Send(ctx, m)
+	}
+
+	if err != nil && err != mail.ErrSuppressed {
+		r.Logger.WithError(err).WithField("mail", name).Error("failed to notify the owner of the email")
+	}
+}
+
+func (r *Resolver) createToken(ctx context.Context, exec boil.ContextExecutor, u *models.User) (string, error) {
+	// user may have multiple roles
+	roles, err := models.UserRoleTypes(ctx, exec, u.ID)
+
+	if err != nil {
+		return "", err
+	}
+
 	// initialize jwt
-	secret := os.Getenv("JWT_SECRET")
-	tokenAuth = jwtauth.New("HS256", []byte(secret), nil)
+	tokenAuth := jwtauth.New("HS256", []byte(r.Config.JWTSecret), nil)
 	token := jwt.New(jwt.SigningMethodRS256)
 	claims := token.Claims.(jwt.MapClaims)
 
 	// set user claims
 
 	claims["user_id"] = &u.ID
-	claims["roles"] = []models.RoleType{models.RoleTypeUser}
+	claims["roles"] = roles
 	claims["uuid"] = utils.RandomUUID()
 
 	jaclaims := jwtauth.Claims(claims)
-	jaclaims.SetIssuedNow()
-
-	// expires after 24 hours
-	jaclaims.SetExpiryIn(24 * time.Hour)
+	now := r.Clock.Now()
+	jaclaims.SetIssuedAt(now)
+	jaclaims.SetExpiry(now.Add(r.Config.TokenLifetime))
 
 	_, tokenString, err := tokenAuth.Encode(jaclaims)
 
@@ -224,7 +341,7 @@ func (r *queryResolver) User(ctx context.Context, userID *int) (*models.User, er
 		_, claims, _ := jwtauth.FromContext(ctx)
 
 		if claims["user_id"] == nil {
-			return nil, fmt.Errorf("Invalid token")
+			return nil, apperror.Unauthenticated(ctx)
 		}
 
 		res := &models.User{
@@ -234,7 +351,7 @@ func (r *queryResolver) User(ctx context.Context, userID *int) (*models.User, er
 		return res, nil
 	}
 
-	db := ctx.Value("db").(*sql.DB)
+	db := r.DB
 	u, err := models.FindUser(ctx, db, *userID)
 
 	if err != nil {
@@ -244,19 +361,84 @@ func (r *queryResolver) User(ctx context.Context, userID *int) (*models.User, er
 	return u, nil
 }
 
-func (r *userResolver) AuthenticationProviders(ctx context.Context, u *models.User) ([]models.AuthenticationProvider, error) {
-	db := ctx.Value("db").(*sql.DB)
+func (r *queryResolver) Users(ctx context.Context, first *int, after *string, last *int, before *string, filter *models.UserFilter, orderBy *models.UserOrder) (*models.UserConnection, error) {
+	db := r.DB
+
+	order := pagination.Order{}
+
+	if orderBy != nil {
+		if orderBy.Field == models.UserOrderFieldCreatedAt {
+			order.Column = models.UserColumns.CreatedAt
+			order.Nullable = true
+		}
+		order.Desc = orderBy.Direction == models.OrderDirectionDesc
+	}
+
+	conn, err := pagination.New(ctx, "User", pagination.Args{First: first, After: after, Last: last, Before: before}, order)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mods := []qm.QueryMod{}
+
+	if filter != nil {
+		mods = userFilterMods(filter)
+	}
+
+	var totalCount int64
+
+	if pagination.TotalCountRequested(ctx) {
+		if totalCount, err = models.Users(mods...).Count(ctx, db); err != nil {
+			return nil, err
+		}
+	}
 
-	aps, err := u.AuthenticationProviders().All(ctx, db)
+	users, err := models.Users(append(mods, conn.QueryMods()...)...).All(ctx, db)
 
 	if err != nil {
 		return nil, err
 	}
 
-	res := make([]models.AuthenticationProvider, len(aps))
-	for i, v := range aps {
-		res[i] = *v
+	res := &models.UserConnection{
+		Edges:      []models.UserEdge{},
+		TotalCount: int(totalCount),
 	}
 
+	res.PageInfo = conn.Build(len(users), func(i int) string {
+		return conn.Cursor(users[i].ID, users[i].CreatedAt)
+	}, func(i int, cursor string) {
+		res.Edges = append(res.Edges, models.UserEdge{Cursor: cursor, Node: *users[i]})
+	})
+
 	return res, nil
 }
+
+func userFilterMods(filter *models.UserFilter) []qm.QueryMod {
+	mods := []qm.QueryMod{}
+
+	if filter.Role != nil {
+		mods = append(mods, qm.Where(
+			"EXISTS (SELECT 1 FROM `user_roles` INNER JOIN `roles` ON `roles`.`id` = `user_roles`.`role_id` WHERE `user_roles`.`user_id` = `users`.`id` AND `roles`.`type` = ?)",
+			filter.Role.String(),
+		))
+	}
+
+	if filter.Username != nil {
+		mods = append(mods, qm.Where("`username` LIKE ?", "%"+likeEscaper.Replace(*filter.Username)+"%"))
+	}
+
+	if filter.CreatedFrom != nil {
+		mods = append(mods, models.UserWhere.CreatedAt.GTE(null.TimeFrom(*filter.CreatedFrom)))
+	}
+
+	if filter.CreatedTo != nil {
+		mods = append(mods, models.UserWhere.CreatedAt.LT(null.TimeFrom(*filter.CreatedTo)))
+	}
+
+	return mods
+}
+
+func (r *userResolver) AuthenticationProviders(ctx context.Context, u *models.User) ([]models.AuthenticationProvider, error) {
+	return dataloader.CtxLoaders(ctx).AuthenticationProvidersByUserID.Load(u.ID)
+}
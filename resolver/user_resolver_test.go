@@ -1,16 +1,23 @@
 package resolver_test
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"log"
+	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/go-chi/jwtauth"
 	"github.com/go-testfixtures/testfixtures"
 	"github.com/machinebox/graphql"
+	"github.com/volatiletech/sqlboiler/boil"
+	"github.com/volatiletech/sqlboiler/queries"
 	"github.com/volatiletech/sqlboiler/queries/qm"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -271,6 +278,423 @@ func (suite *UserResolverSuite) TestEmailAuthentication() {
 	}
 }
 
+// Argument validation test
+func (suite *UserResolverSuite) TestUserArgumentValidation() {
+	ctx := context.Background()
+
+	req := graphql.NewRequest(`
+		query user {
+			user(id: 0) {
+				id
+			}
+		}
+	`)
+
+	var res map[string]map[string]interface{}
+	err := suite.client.Run(ctx, req, &res)
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "greater than or equal to 1")
+
+	// Japanese message with translated field name
+	req.Header.Add("Accept-Language", "ja")
+	err = suite.client.Run(ctx, req, &res)
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "1以上の数値")
+}
+
+// Errors have the code and invalid fields in the extensions
+func (suite *UserResolverSuite) TestErrorCodes() {
+	post := func(query string) []map[string]interface{} {
+		body, _ := json.Marshal(map[string]interface{}{"query": query})
+		res, err := http.Post(suite.ts.URL+"/query", "application/json", bytes.NewReader(body))
+		suite.Require().NoError(err)
+		defer res.Body.Close()
+
+		var payload struct {
+			Errors []map[string]interface{} `json:"errors"`
+		}
+		suite.Require().NoError(json.NewDecoder(res.Body).Decode(&payload))
+
+		return payload.Errors
+	}
+
+	// every invalid field is reported in a single error
+	errs := post(`
+		mutation createUser {
+			createUser(input: {email: "test@example", password: "1234", firstName: "shuhei", lastName: "hayashibara", phoneNumber: "03-1234-5678"}) {
+				token
+			}
+		}
+	`)
+
+	suite.Len(errs, 1)
+	suite.Contains(errs[0]["message"], "Password")
+	suite.Contains(errs[0]["message"], "Email")
+
+	extensions := errs[0]["extensions"].(map[string]interface{})
+	suite.Equal("VALIDATION_FAILED", extensions["code"])
+
+	fields := extensions["fields"].(map[string]interface{})
+	suite.Len(fields, 2)
+	suite.Contains(fields, "input.email")
+	suite.Contains(fields, "input.password")
+
+	// email is already registered
+	errs = post(`
+		mutation createUser {
+			createUser(input: {email: "success@simulator.amazonses.com", password: "123456", firstName: "shuhei", lastName: "hayashibara", phoneNumber: "03-1234-5678"}) {
+				token
+			}
+		}
+	`)
+
+	suite.Len(errs, 1)
+	suite.Equal("CONFLICT", errs[0]["extensions"].(map[string]interface{})["code"])
+
+	// wrong password
+	errs = post(`
+		mutation authUser {
+			authUser(input: {email: "success@simulator.amazonses.com", password: "654321"}) {
+				token
+			}
+		}
+	`)
+
+	suite.Len(errs, 1)
+	suite.Equal("UNAUTHENTICATED", errs[0]["extensions"].(map[string]interface{})["code"])
+}
+
+// Records which don't exist are reported as NOT_FOUND instead of INTERNAL
+func (suite *UserResolverSuite) TestUserNotFound() {
+	ctx := context.Background()
+
+	authReq := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "admin@example.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	var authResponse map[string]map[string]interface{}
+	suite.NoError(suite.client.Run(ctx, authReq, &authResponse))
+
+	body, _ := json.Marshal(map[string]interface{}{"query": `{ user(id: 999) { id } }`})
+	req, err := http.NewRequest("POST", suite.ts.URL+"/query", bytes.NewReader(body))
+	suite.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+authResponse["authUser"]["token"].(string))
+
+	res, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+	defer res.Body.Close()
+
+	var payload struct {
+		Errors []map[string]interface{} `json:"errors"`
+	}
+	suite.Require().NoError(json.NewDecoder(res.Body).Decode(&payload))
+
+	suite.Len(payload.Errors, 1)
+	suite.Equal("The requested resource is not found", payload.Errors[0]["message"])
+	suite.Equal("NOT_FOUND", payload.Errors[0]["extensions"].(map[string]interface{})["code"])
+	suite.Equal([]interface{}{"user"}, payload.Errors[0]["path"])
+}
+
+// Field level permission test
+func (suite *UserResolverSuite) TestEmailFieldPermission() {
+	ctx := context.Background()
+
+	// authenticate as fixture user
+	authReq := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "success@simulator.amazonses.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	var authResponse map[string]map[string]interface{}
+	suite.NoError(suite.client.Run(ctx, authReq, &authResponse))
+	token := authResponse["authUser"]["token"].(string)
+
+	// test cases
+	cases := []struct {
+		name  string
+		token string
+		valid bool
+		email interface{}
+	}{
+		{name: "case: resource owner can read email", token: token, valid: true, email: "success@simulator.amazonses.com"},
+		{name: "case: anonymous user can't read email", token: "", valid: false, email: nil},
+	}
+
+	for _, c := range cases {
+		req := graphql.NewRequest(`
+			query user {
+				user(id: 1) {
+					databaseId
+					email
+				}
+			}
+		`)
+
+		if c.token != "" {
+			req.Header.Add("Authorization", "Bearer "+c.token)
+		}
+
+		var res map[string]map[string]interface{}
+		err := suite.client.Run(ctx, req, &res)
+
+		// the query itself is not failed
+		suite.Equal(float64(1), res["user"]["databaseId"], c.name)
+		suite.Equal(c.email, res["user"]["email"], c.name)
+
+		if c.valid {
+			suite.NoError(err, c.name)
+		} else {
+			suite.Error(err, c.name)
+			suite.Contains(err.Error(), "not granted", c.name)
+		}
+	}
+}
+
+// Users pagination test
+func (suite *UserResolverSuite) TestUsers() {
+	ctx := context.Background()
+
+	// authenticate as fixture super admin
+	authReq := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "admin@example.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	var authResponse map[string]map[string]interface{}
+	suite.NoError(suite.client.Run(ctx, authReq, &authResponse))
+	token := authResponse["authUser"]["token"].(string)
+
+	query := `
+		query users($first: Int, $after: String, $last: Int, $before: String, $filter: UserFilter, $orderBy: UserOrder) {
+			users(first: $first, after: $after, last: $last, before: $before, filter: $filter, orderBy: $orderBy) {
+				edges {
+					cursor
+					node {
+						databaseId
+					}
+				}
+				pageInfo {
+					hasNextPage
+					hasPreviousPage
+					startCursor
+					endCursor
+				}
+				totalCount
+			}
+		}
+	`
+
+	run := func(vars map[string]interface{}) ([]float64, map[string]interface{}, float64) {
+		req := graphql.NewRequest(query)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		for k, v := range vars {
+			req.Var(k, v)
+		}
+
+		var res map[string]map[string]interface{}
+		suite.NoError(suite.client.Run(ctx, req, &res))
+
+		ids := []float64{}
+		for _, e := range res["users"]["edges"].([]interface{}) {
+			ids = append(ids, e.(map[string]interface{})["node"].(map[string]interface{})["databaseId"].(float64))
+		}
+
+		return ids, res["users"]["pageInfo"].(map[string]interface{}), res["users"]["totalCount"].(float64)
+	}
+
+	// paginate forward
+	ids, pageInfo, totalCount := run(map[string]interface{}{"first": 1})
+	suite.Equal([]float64{1}, ids)
+	suite.True(pageInfo["hasNextPage"].(bool))
+	suite.False(pageInfo["hasPreviousPage"].(bool))
+	suite.Equal(float64(2), totalCount)
+
+	ids, pageInfo, _ = run(map[string]interface{}{"first": 1, "after": pageInfo["endCursor"]})
+	suite.Equal([]float64{2}, ids)
+	suite.False(pageInfo["hasNextPage"].(bool))
+	suite.True(pageInfo["hasPreviousPage"].(bool))
+
+	// paginate backward
+	ids, pageInfo, _ = run(map[string]interface{}{"last": 1})
+	suite.Equal([]float64{2}, ids)
+	suite.True(pageInfo["hasPreviousPage"].(bool))
+
+	ids, pageInfo, _ = run(map[string]interface{}{"last": 1, "before": pageInfo["startCursor"]})
+	suite.Equal([]float64{1}, ids)
+	suite.False(pageInfo["hasPreviousPage"].(bool))
+
+	// users created at the same time are ordered by id
+	orderBy := map[string]interface{}{"field": "CREATED_AT", "direction": "DESC"}
+	ids, pageInfo, _ = run(map[string]interface{}{"first": 1, "orderBy": orderBy})
+	suite.Equal([]float64{2}, ids)
+
+	ids, _, _ = run(map[string]interface{}{"first": 1, "after": pageInfo["endCursor"], "orderBy": orderBy})
+	suite.Equal([]float64{1}, ids)
+
+	// users without created_at are sorted as the oldest and not skipped
+	_, err := queries.Raw("UPDATE `users` SET `created_at` = NULL WHERE `id` = 1").ExecContext(ctx, suite.db)
+	suite.Require().NoError(err)
+
+	orderBy["direction"] = "ASC"
+	ids, pageInfo, _ = run(map[string]interface{}{"first": 1, "orderBy": orderBy})
+	suite.Equal([]float64{1}, ids)
+
+	ids, pageInfo, _ = run(map[string]interface{}{"first": 1, "after": pageInfo["endCursor"], "orderBy": orderBy})
+	suite.Equal([]float64{2}, ids)
+
+	ids, _, _ = run(map[string]interface{}{"last": 1, "before": pageInfo["startCursor"], "orderBy": orderBy})
+	suite.Equal([]float64{1}, ids)
+
+	// filter
+	ids, _, totalCount = run(map[string]interface{}{"filter": map[string]interface{}{"role": "SUPER_ADMIN"}})
+	suite.Equal([]float64{2}, ids)
+	suite.Equal(float64(1), totalCount)
+
+	ids, _, _ = run(map[string]interface{}{"filter": map[string]interface{}{"username": "simulator"}})
+	suite.Equal([]float64{1}, ids)
+
+	// normal user is not permitted
+	authReq = graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "success@simulator.amazonses.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+	suite.NoError(suite.client.Run(ctx, authReq, &authResponse))
+
+	req := graphql.NewRequest(query)
+	req.Header.Add("Authorization", "Bearer "+authResponse["authUser"]["token"].(string))
+
+	var res map[string]map[string]interface{}
+	err = suite.client.Run(ctx, req, &res)
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "not granted")
+}
+
+// Relations of users are loaded in batches
+func (suite *UserResolverSuite) TestUsersQueryCount() {
+	ctx := context.Background()
+
+	authReq := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "admin@example.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	var authResponse map[string]map[string]interface{}
+	suite.NoError(suite.client.Run(ctx, authReq, &authResponse))
+	token := authResponse["authUser"]["token"].(string)
+
+	// count queries run while listing users with their relations
+	count := func() int {
+		req := graphql.NewRequest(`
+			query users {
+				users(first: 100) {
+					edges {
+						node {
+							authenticationProviders {
+								id
+							}
+							profile {
+								firstName
+							}
+						}
+					}
+				}
+			}
+		`)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		var buf bytes.Buffer
+		boil.DebugMode, boil.DebugWriter = true, &buf
+		defer func() { boil.DebugMode, boil.DebugWriter = false, os.Stdout }()
+
+		var res map[string]interface{}
+		suite.NoError(suite.client.Run(ctx, req, &res))
+
+		return strings.Count(strings.ToUpper(buf.String()), "SELECT ")
+	}
+
+	before := count()
+	suite.NotZero(before)
+
+	for i := 0; i < 3; i++ {
+		req := graphql.NewRequest(`
+			mutation createUser($input: CreateUserInput!) {
+				createUser(input: $input) {
+					id
+				}
+			}
+		`)
+		req.Var("input", map[string]interface{}{
+			"email":       fmt.Sprintf("user%d@example.com", i),
+			"password":    "123456",
+			"firstName":   "shuhei",
+			"lastName":    "hayashibara",
+			"phoneNumber": "03-1234-5678",
+		})
+
+		var res map[string]interface{}
+		suite.NoError(suite.client.Run(ctx, req, &res))
+	}
+
+	suite.Equal(before, count())
+}
+
+// Users are counted only when totalCount is selected
+func (suite *UserResolverSuite) TestUsersTotalCount() {
+	ctx := context.Background()
+
+	authReq := graphql.NewRequest(`
+		mutation authUser {
+			authUser(input: {email: "admin@example.com", password: "123456"}) {
+				token
+			}
+		}
+	`)
+
+	var authResponse map[string]map[string]interface{}
+	suite.NoError(suite.client.Run(ctx, authReq, &authResponse))
+	token := authResponse["authUser"]["token"].(string)
+
+	// queries returns the queries run by the query
+	queries := func(query string) string {
+		req := graphql.NewRequest(query)
+		req.Header.Add("Authorization", "Bearer "+token)
+
+		var buf bytes.Buffer
+		boil.DebugMode, boil.DebugWriter = true, &buf
+		defer func() { boil.DebugMode, boil.DebugWriter = false, os.Stdout }()
+
+		var res map[string]interface{}
+		suite.NoError(suite.client.Run(ctx, req, &res))
+
+		return strings.ToUpper(buf.String())
+	}
+
+	suite.NotContains(queries(`query users { users(first: 10) { edges { node { id } } } }`), "COUNT(")
+	suite.Contains(queries(`query users { users(first: 10) { totalCount } }`), "COUNT(")
+	suite.Contains(queries(`query users { users(first: 10) { ...count } } fragment count on UserConnection { totalCount }`), "COUNT(")
+}
+
 func TestUserResolverSuite(t *testing.T) {
 	suite.Run(t, new(UserResolverSuite))
 }
@@ -0,0 +1,297 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/99designs/gqlgen/graphql"
+	validation "github.com/go-ozzo/ozzo-validation"
+	is "github.com/go-ozzo/ozzo-validation/is"
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/translations"
+	"github.com/vektah/gqlparser/ast"
+)
+
+// ValidationMiddleware validates field arguments and input object fields with
+// @length, @pattern, @email, @range, @phoneNumber and @countryCode directives before the field is resolved.
+// gqlgen executes only FIELD_DEFINITION directives, so directives on
+// ARGUMENT_DEFINITION and INPUT_FIELD_DEFINITION are evaluated here.
+// The regexes of @pattern are compiled at once and the error is returned if any of them is invalid.
+func ValidationMiddleware(schema *ast.Schema) (graphql.FieldMiddleware, error) {
+	patterns, err := compilePatterns(schema)
+
+	if err != nil {
+		return nil, err
+	}
+
+	v := &validator{schema: schema, patterns: patterns}
+
+	return func(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+		rctx := graphql.GetResolverContext(ctx)
+
+		if rctx.Field.Definition == nil || len(rctx.Field.Definition.Arguments) == 0 {
+			return next(ctx)
+		}
+
+		raw := rctx.Field.ArgumentMap(graphql.GetRequestContext(ctx).Variables)
+		errs := validation.Errors{}
+		labels := map[string]string{}
+
+		for _, arg := range rctx.Field.Definition.Arguments {
+			v.validateValue(ctx, errs, labels, arg.Name, arg.Name, arg.Type, arg.Directives, raw[arg.Name])
+		}
+
+		if len(errs) > 0 {
+			graphql.AddError(ctx, apperror.Validation(ctx, errs, labels))
+			return nil, nil
+		}
+
+		return next(ctx)
+	}, nil
+}
+
+// validator holds the schema and the compiled regexes of @pattern by the source
+type validator struct {
+	schema   *ast.Schema
+	patterns map[string]*regexp.Regexp
+}
+
+// compilePatterns compiles the regexes of @pattern on the arguments and the input fields in the schema
+func compilePatterns(schema *ast.Schema) (map[string]*regexp.Regexp, error) {
+	patterns := map[string]*regexp.Regexp{}
+
+	compile := func(owner string, directives ast.DirectiveList) error {
+		for _, d := range directives {
+			if d.Name != "pattern" {
+				continue
+			}
+
+			regex, _ := d.ArgumentMap(nil)["regex"].(string)
+
+			if _, ok := patterns[regex]; ok {
+				continue
+			}
+
+			re, err := regexp.Compile(regex)
+
+			if err != nil {
+				return fmt.Errorf("invalid @pattern on %s: %v", owner, err)
+			}
+
+			patterns[regex] = re
+		}
+
+		return nil
+	}
+
+	for _, def := range schema.Types {
+		for _, f := range def.Fields {
+			if err := compile(def.Name+"."+f.Name, f.Directives); err != nil {
+				return nil, err
+			}
+
+			for _, arg := range f.Arguments {
+				if err := compile(def.Name+"."+f.Name+"("+arg.Name+")", arg.Directives); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return patterns, nil
+}
+
+// validateValue applies validation directives to the value and walks into input objects.
+// Errors are keyed by the path of the value like "input.email" and labels hold
+// the translated field name of each path.
+func (v *validator) validateValue(ctx context.Context, errs validation.Errors, labels map[string]string, path string, name string, t *ast.Type, directives ast.DirectiveList, value interface{}) {
+	if value == nil {
+		return
+	}
+
+	if rules := v.validationRules(ctx, directives); len(rules) > 0 {
+		if err := validation.Validate(value, rules...); err != nil {
+			errs[path] = err
+			labels[path] = translations.TWithDefault(ctx, snakeCase(name), name)
+			return
+		}
+	}
+
+	// list of values
+	if t.Elem != nil {
+		if values, ok := value.([]interface{}); ok {
+			for i, item := range values {
+				v.validateValue(ctx, errs, labels, fmt.Sprintf("%s.%d", path, i), name, t.Elem, nil, item)
+			}
+		}
+		return
+	}
+
+	// input object
+	def := v.schema.Types[t.Name()]
+	fields, ok := value.(map[string]interface{})
+
+	if def == nil || def.Kind != ast.InputObject || !ok {
+		return
+	}
+
+	for _, f := range def.Fields {
+		v.validateValue(ctx, errs, labels, path+"."+f.Name, f.Name, f.Type, f.Directives, fields[f.Name])
+	}
+}
+
+// e164 matches with E.164 phone number. The leading + is required.
+var e164 = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// validationRules builds validation rules from the directives
+func (v *validator) validationRules(ctx context.Context, directives ast.DirectiveList) []validation.Rule {
+	rules := []validation.Rule{}
+
+	for _, d := range directives {
+		args := d.ArgumentMap(nil)
+
+		switch d.Name {
+		case "length":
+			rules = append(rules, lengthRules(ctx, intPtr(args["min"]), intPtr(args["max"]))...)
+		case "pattern":
+			regex, _ := args["regex"].(string)
+			rules = append(rules, patternRule(ctx, v.patterns[regex]))
+		case "email":
+			rules = append(rules, is.Email.Error(translations.T(ctx, "email_validation")))
+		case "range":
+			rules = append(rules, rangeRule(ctx, floatPtr(args["min"]), floatPtr(args["max"])))
+		case "phoneNumber":
+			rules = append(rules, validation.Match(e164).Error(translations.T(ctx, "phone_number_validation")))
+		case "countryCode":
+			rules = append(rules, is.CountryCode2.Error(translations.T(ctx, "country_code_validation")))
+		}
+	}
+
+	return rules
+}
+
+// lengthRules returns the rules for the length of string or list
+func lengthRules(ctx context.Context, min *int, max *int) []validation.Rule {
+	rules := []validation.Rule{}
+
+	lo, hi := 0, 0
+
+	if min != nil {
+		lo = *min
+	}
+
+	if max != nil {
+		hi = *max
+	}
+
+	if lo > 0 {
+		rules = append(rules, validation.Required.Error(translations.T(ctx, "required")))
+	}
+
+	switch {
+	case min != nil && max != nil:
+		rules = append(rules, validation.Length(lo, hi).Error(translations.TWithTemplateData(ctx,
+			"length_validation",
+			map[string]interface{}{"Min": lo, "Max": hi}),
+		))
+	case min != nil:
+		rules = append(rules, validation.Length(lo, 0).Error(translations.TWithTemplateData(ctx,
+			"length_min_validation",
+			map[string]interface{}{"Min": lo}),
+		))
+	case max != nil:
+		rules = append(rules, validation.Length(0, hi).Error(translations.TWithTemplateData(ctx,
+			"length_max_validation",
+			map[string]interface{}{"Max": hi}),
+		))
+	}
+
+	return rules
+}
+
+// patternRule returns the rule which the string must match with
+func patternRule(ctx context.Context, re *regexp.Regexp) validation.Rule {
+	return validation.Match(re).Error(translations.T(ctx, "pattern_validation"))
+}
+
+// rangeRule returns the rule for the range of number
+func rangeRule(ctx context.Context, min *float64, max *float64) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		var f float64
+
+		switch v := value.(type) {
+		case int64:
+			f = float64(v)
+		case int:
+			f = float64(v)
+		case float64:
+			f = v
+		default:
+			return nil
+		}
+
+		if (min != nil && f < *min) || (max != nil && f > *max) {
+			data := map[string]interface{}{}
+			key := "range_validation"
+
+			switch {
+			case min != nil && max != nil:
+				data["Min"], data["Max"] = *min, *max
+			case min != nil:
+				data["Min"], key = *min, "range_min_validation"
+			default:
+				data["Max"], key = *max, "range_max_validation"
+			}
+
+			return errors.New(translations.TWithTemplateData(ctx, key, data))
+		}
+
+		return nil
+	})
+}
+
+func intPtr(v interface{}) *int {
+	switch i := v.(type) {
+	case int64:
+		n := int(i)
+		return &n
+	case int:
+		return &i
+	}
+
+	return nil
+}
+
+func floatPtr(v interface{}) *float64 {
+	switch f := v.(type) {
+	case float64:
+		return &f
+	case int64:
+		n := float64(f)
+		return &n
+	}
+
+	return nil
+}
+
+// snakeCase converts lowerCamelCase field name into snake_case translation key
+func snakeCase(s string) string {
+	var b strings.Builder
+
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -3,36 +3,14 @@ package server
 import (
 	"database/sql"
 	"fmt"
-	"log"
-	"os"
 	"time"
 
 	"github.com/volatiletech/sqlboiler/boil"
 )
 
+// OpenDBConnection connects to the database in the config
 func (s *Server) OpenDBConnection() *sql.DB {
-	//Check if environment variable exists
-	envs := []string{
-		"DB_HOST",
-		"DB_PORT",
-		"DB_USERNAME",
-		"DB_PASSWORD",
-		"DB_DATABASE",
-	}
-
-	for _, env := range envs {
-		if _, found := os.LookupEnv(env); !found {
-			log.Fatalf("Required environment variable %s not found.", env)
-		}
-	}
-
-	hostname := os.Getenv("DB_HOST")
-	port := os.Getenv("DB_PORT")
-	username := os.Getenv("DB_USERNAME")
-	password := os.Getenv("DB_PASSWORD")
-	database := os.Getenv("DB_DATABASE")
-
-	dsn := username + ":" + password + "@tcp(" + hostname + ":" + port + ")/" + database + "?charset=utf8mb4&parseTime=True&loc=Local"
+	dsn := s.config.App.DB.DSN()
 
 	var db *sql.DB
 	var err error
@@ -54,7 +32,7 @@ func (s *Server) OpenDBConnection() *sql.DB {
 		panic("failed to connect database")
 	}
 
-	if env, found := os.LookupEnv("APP_ENV"); !found || env != "production" {
+	if s.config.App.Env != "production" {
 		boil.DebugMode = true
 	}
 
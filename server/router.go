@@ -2,15 +2,18 @@ package server
 
 import (
 	"database/sql"
+	"expvar"
 	"log"
-	"os"
+	"net/http"
 	"path"
+	"strings"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"golang.org/x/text/language"
 
 	"github.com/BurntSushi/toml"
 
+	"github.com/shufo/go-graphql-boilerplate/clock"
 	"github.com/shufo/go-graphql-boilerplate/translations"
 
 	"github.com/casbin/casbin/model"
@@ -22,19 +25,36 @@ import (
 	"github.com/casbin/casbin"
 
 	"github.com/99designs/gqlgen/handler"
+	"github.com/gorilla/websocket"
+	"github.com/rs/cors"
 	"github.com/shufo/go-graphql-boilerplate/dataloader"
 	"github.com/shufo/go-graphql-boilerplate/graph/generated"
 	"github.com/shufo/go-graphql-boilerplate/resolver"
-	"github.com/rs/cors"
 
-	"github.com/shufo/go-graphql-boilerplate/auth"
-	"github.com/shufo/go-graphql-boilerplate/logger"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/jwtauth"
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/auth"
+	"github.com/shufo/go-graphql-boilerplate/blob"
+	"github.com/shufo/go-graphql-boilerplate/clientip"
+	"github.com/shufo/go-graphql-boilerplate/logger"
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/shufo/go-graphql-boilerplate/mailqueue"
+	"github.com/shufo/go-graphql-boilerplate/notification"
+	"github.com/shufo/go-graphql-boilerplate/persisted"
+	"github.com/shufo/go-graphql-boilerplate/pubsub"
+	"github.com/shufo/go-graphql-boilerplate/querylimit"
+	"github.com/shufo/go-graphql-boilerplate/ratelimit"
+	"github.com/shufo/go-graphql-boilerplate/sns"
+	"github.com/shufo/go-graphql-boilerplate/suppression"
+	"github.com/shufo/go-graphql-boilerplate/upload"
 	"github.com/sirupsen/logrus"
 )
 
+// maxUploadSize is the maximum size of multipart request in bytes
+const maxUploadSize = 10 << 20
+
 // Router sets router settings
 func (s *Server) Router(db *sql.DB) *chi.Mux {
 	/*
@@ -48,29 +68,75 @@ func (s *Server) Router(db *sql.DB) *chi.Mux {
 		DisableTimestamp: true,
 	}
 
+	config := s.config.App
+
 	// initialize Casbin
-	casbin := initCasbin()
+	casbin := initCasbin(config.Redis.Address())
 
 	// initialize i18n
 	bundle := initI18n()
 
-	// JWT setting
-	secret, found := os.LookupEnv("JWT_SECRET")
+	// initialize pub/sub for subscriptions
+	ps := pubsub.New(config.PubSub, config.Redis)
+
+	// initialize blob storage for uploaded files
+	storage := blob.New(config.Storage)
+
+	tokenAuth := jwtauth.New("HS256", []byte(config.JWTSecret), nil)
 
-	if !found {
-		log.Fatal("There is no JWT_SECRET variable in environment variables")
+	// mail bodies are rendered from the templates bundled in mail/templates
+	templates, err := mail.NewTemplates()
+
+	if err != nil {
+		log.Fatalf("failed to load mail templates: %v", err)
 	}
 
-	tokenAuth := jwtauth.New("HS256", []byte(secret), nil)
+	// mails are queued in db and delivered by the workers started with RunMailQueue.
+	// Mails to the addresses which bounced or complained are refused both on queueing and on delivery.
+	transport := suppression.NewMailer(db, mail.NewMailer(config.Mail, customLogger))
+	s.mailQueue = mailqueue.New(db, transport, clock.Real{}, customLogger, config.MailQueue)
+
+	mailer := suppression.NewMailer(db, s.mailQueue)
+
+	// rate limits of the requests and the fields with @rateLimit
+	rateLimitStore := s.config.RateLimitStore
+
+	if rateLimitStore == nil {
+		rateLimitStore = initRateLimitStore(config.RateLimit.Store, config.Redis.Address())
+	}
+
+	// dependencies of resolvers
+	rslv := &resolver.Resolver{
+		DB:            db,
+		Enforcer:      casbin,
+		Mailer:        mailer,
+		MailQueue:     s.mailQueue,
+		MailTemplates: templates,
+		Notifier:      notification.NewNotifier(db, mailer, templates, clock.Real{}, config),
+		Clock:         clock.Real{},
+		Config:        config,
+		Logger:        customLogger,
+		PubSub:        ps,
+		Storage:       storage,
+		RateLimiter:   rateLimitStore,
+	}
+
+	if err := rslv.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// the address of the client is taken from the proxy headers only behind the trusted proxies
+	proxies, err := clientip.ParseProxies(config.TrustedProxies)
+
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// middlewares
-	s.router.Use(middleware.WithValue("db", db))
-	s.router.Use(middleware.WithValue("casbin", casbin))
-	s.router.Use(middleware.WithValue("bundle", bundle))
 	s.router.Use(middleware.RequestID)
-	s.router.Use(middleware.RealIP)
+	s.router.Use(clientip.Middleware(proxies))
 	s.router.Use(jwtauth.Verifier(tokenAuth))
-	s.router.Use(translations.Middleware)
+	s.router.Use(translations.Middleware(bundle))
 
 	// Logger
 	if s.config.Logging {
@@ -80,33 +146,17 @@ func (s *Server) Router(db *sql.DB) *chi.Mux {
 	s.router.Use(auth.Middleware)
 	s.router.Use(middleware.Recoverer)
 
-	allowedOrigins := []string{"*"}
-
-	if env, found := os.LookupEnv("APP_ENV"); found {
-		switch env {
-		case "production":
-			allowedOrigins = []string{"https://www.example.jp"}
-		case "development":
-			allowedOrigins = []string{"https://dev.www.example.jp", "https://dev.api.example.jp"}
-		}
-	}
+	allowedOrigins := config.CORS.AllowedOrigins
 
 	// CORS setting
-	cors := cors.New(cors.Options{
-		// Use this to allow specific origin hosts
-		AllowedOrigins: allowedOrigins,
-		// AllowOriginFunc:  func(r *http.Request, origin string) bool { return true },
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
-		AllowCredentials: true,
-		MaxAge:           300, // Maximum value not ignored by any of major browsers
-	})
+	s.router.Use(cors.New(corsOptions(allowedOrigins)).Handler)
 
-	s.router.Use(cors.Handler)
+	// Rate limit after CORS so that browsers can read the errors
+	requestRule := ratelimit.Rule{Limit: config.RateLimit.Requests, Window: config.RateLimit.Window}
+	s.router.Use(ratelimit.Middleware(rateLimitStore, requestRule, customLogger))
 
 	// Dataloader for GraphQL
-	s.router.Use(dataloader.DataloaderMiddleware)
+	s.router.Use(dataloader.DataloaderMiddleware(db))
 
 	/*
 	 * Routing settings
@@ -114,18 +164,151 @@ func (s *Server) Router(db *sql.DB) *chi.Mux {
 
 	// GraphQL playground
 	s.router.Handle("/", handler.Playground("GraphQL playground", "/query"))
-	c := generated.Config{Resolvers: &resolver.Resolver{}, Directives: resolver.NewDirectives()}
+	c := generated.Config{
+		Resolvers:  rslv,
+		Directives: resolver.NewDirectives(rslv),
+		Complexity: resolver.NewComplexity(),
+	}
+	es := generated.NewExecutableSchema(c)
+
+	validationMiddleware, err := resolver.ValidationMiddleware(es.Schema())
+
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	// GraphQL endpoint
-	s.router.Handle("/query", handler.GraphQL(generated.NewExecutableSchema(c)))
+	// Only queries in the manifest are accepted if it is given
+	manifest := initPersistedQueryManifest(config.PersistedQueryManifest)
+	apqCache := persisted.NewCache(1000, config.Redis.Address())
+
+	// the operations exceeding the query limits are rejected before they are counted by the rate limit
+	operationRule := ratelimit.Rule{Limit: config.RateLimit.OperationRequests, Window: config.RateLimit.OperationWindow}
+	limited := ratelimit.Schema(querylimit.Schema(es, config.QueryLimit), rateLimitStore, operationRule, customLogger)
+
+	// GraphQL endpoint. Subscriptions are served over websocket on the same endpoint
+	// Files are uploaded by multipart request
+	s.router.With(upload.Middleware(maxUploadSize), persisted.Middleware(apqCache, manifest)).Handle("/query", handler.GraphQL(
+		auth.WebsocketSchema(persisted.Schema(limited, manifest), tokenAuth),
+		handler.ResolverMiddleware(validationMiddleware),
+		// hide internal errors like database errors from clients in production
+		handler.ErrorPresenter(apperror.Presenter(customLogger, config.Env == "production")),
+		handler.WebsocketUpgrader(websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return isAllowedOrigin(allowedOrigins, r.Header.Get("Origin"))
+			},
+		}),
+	))
+
+	// Runtime metrics like dataloader batches in JSON.
+	// Expose only on trusted networks as it includes the command line and memory stats.
+	if config.MetricsEnabled {
+		s.router.Handle("/debug/vars", expvar.Handler())
+	}
+
+	// SES bounce and complaint notifications from the SNS topics in the config
+	if config.SES.WebhookEnabled {
+		certs := s.config.SNSCertificates
+
+		if certs == nil {
+			certs = sns.NewHTTPCertificates(nil)
+		}
+
+		verifier := sns.NewVerifier(certs, config.SES.SNSTopicARNs)
+		s.router.Handle("/webhooks/ses", suppression.SESHandler(db, verifier, customLogger))
+	}
+
+	// One-click unsubscribe links in the mails sent by the notifier
+	s.router.Handle("/unsubscribe", notification.UnsubscribeHandler(db, config.UnsubscribeSecret, clock.Real{}, customLogger))
+
+	// Preview rendered mails like /mail/preview/en/password_reset?ResetLink=https://example.jp/
+	if config.Env != "production" {
+		s.router.Handle("/mail/preview*", http.StripPrefix("/mail/preview", templates.PreviewHandler()))
+	}
+
+	// Serve uploaded files if they are stored locally
+	if local, ok := storage.(*blob.Local); ok {
+		s.router.Handle(local.Path()+"/*", local.Handler())
+	}
 
 	return s.router
 }
 
-func initCasbin() *casbin.CachedEnforcer {
+// corsOptions returns the CORS setting allowing the origins. No origin is allowed if the list is empty,
+// and the credentials are not sent to any origin allowed by "*".
+func corsOptions(allowedOrigins []string) cors.Options {
+	o := cors.Options{
+		// Use this to allow specific origin hosts
+		AllowedOrigins:   allowedOrigins,
+		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		ExposedHeaders:   []string{"Link", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After"},
+		AllowCredentials: true,
+		MaxAge:           300, // Maximum value not ignored by any of major browsers
+	}
+
+	// cors allows any origin if the list is empty
+	if len(allowedOrigins) == 0 {
+		o.AllowOriginFunc = func(origin string) bool { return false }
+	}
+
+	for _, origin := range allowedOrigins {
+		if origin == "*" {
+			o.AllowCredentials = false
+		}
+	}
+
+	return o
+}
+
+// isAllowedOrigin reports whether the origin is allowed by CORS setting.
+// Requests without Origin header are not from browsers and allowed.
+func isAllowedOrigin(allowedOrigins []string, origin string) bool {
+	if origin == "" {
+		return true
+	}
+
+	for _, o := range allowedOrigins {
+		if o == "*" || o == origin {
+			return true
+		}
+	}
+
+	return false
+}
+
+// initRateLimitStore returns the store of the rate limits selected by the config
+func initRateLimitStore(store string, redisAddress string) ratelimit.Store {
+	switch store {
+	case "redis":
+		return ratelimit.NewRedisStore(redisAddress, clock.Real{})
+	case "memory":
+		return ratelimit.NewMemoryStore(clock.Real{})
+	}
+
+	log.Fatalf("unknown rate limit store: %s", store)
+
+	return nil
+}
+
+// initPersistedQueryManifest loads the manifest at path.
+// It returns nil if path is empty to accept any query.
+func initPersistedQueryManifest(path string) persisted.Manifest {
+	if path == "" {
+		return nil
+	}
+
+	manifest, err := persisted.LoadManifest(path)
+
+	if err != nil {
+		log.Fatalf("failed to load persisted query manifest: %v", err)
+	}
+
+	return manifest
+}
+
+func initCasbin(redisAddress string) *casbin.CachedEnforcer {
 	// redis adapter
-	host := os.Getenv("REDIS_HOST")
-	a := redisadapter.NewAdapter("tcp", host+":6379")
+	a := redisadapter.NewAdapter("tcp", redisAddress)
 
 	// load config from packr
 	box := packr.NewBox("../configs")
@@ -144,9 +327,48 @@ func initCasbin() *casbin.CachedEnforcer {
 	// Load the policy from DB.
 	e.LoadPolicy()
 
+	// Seed the default policies missing from the store, like the ones added after deployed
+	seedCasbinPolicy(e, box)
+
 	return e
 }
 
+// seedCasbinPolicy adds the rules in casbin_policy.csv which are not in the policy yet.
+// The rules already stored are kept, so it can be run on every start.
+func seedCasbinPolicy(e *casbin.CachedEnforcer, box packr.Box) {
+	policyText, err := box.FindString("casbin_policy.csv")
+
+	if err != nil {
+		log.Fatal("casbin default policy not found")
+	}
+
+	added := false
+
+	for _, line := range strings.Split(policyText, "\n") {
+		fields := strings.Split(line, ",")
+
+		if len(fields) < 2 || strings.TrimSpace(fields[0]) != "p" {
+			continue
+		}
+
+		rule := []interface{}{}
+		for _, f := range fields[1:] {
+			rule = append(rule, strings.TrimSpace(f))
+		}
+
+		if !e.HasPolicy(rule...) {
+			added = e.AddPolicy(rule...) || added
+		}
+	}
+
+	// the redis adapter saves only the whole policy
+	if added {
+		if err := e.SavePolicy(); err != nil {
+			log.Fatalf("failed to save casbin policy: %v", err)
+		}
+	}
+}
+
 func initI18n() *i18n.Bundle {
 	// Init i18n package
 	bundle := &i18n.Bundle{DefaultLanguage: language.English}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/casbin/casbin"
+	redisadapter "github.com/casbin/redis-adapter"
+	"github.com/gobuffalo/packr"
+	"github.com/rs/cors"
+	"github.com/stretchr/testify/suite"
+)
+
+type RouterSuite struct {
+	suite.Suite
+	redisAddress string
+}
+
+func (suite *RouterSuite) SetupSuite() {
+	suite.redisAddress = os.Getenv("REDIS_HOST") + ":6379"
+}
+
+// The default policies missing from the store are added to the stored ones
+func (suite *RouterSuite) TestSeedCasbinPolicy() {
+	modelText, err := packr.NewBox("../configs").FindString("casbin_rbac.conf")
+	suite.Require().NoError(err)
+
+	// the policy stored before the default rules of user.email are added
+	stored := casbin.NewEnforcer(casbin.NewModel(modelText), redisadapter.NewAdapter("tcp", suite.redisAddress))
+	stored.ClearPolicy()
+	stored.AddPolicy("SUPER_ADMIN", "user.name", "read")
+	suite.Require().NoError(stored.SavePolicy())
+
+	e := initCasbin(suite.redisAddress)
+	suite.True(e.HasPolicy("SUPER_ADMIN", "user.name", "read"))
+	suite.True(e.HasPolicy("SUPER_ADMIN", "user.email", "read"))
+	suite.True(e.HasPolicy("RESOURCE_OWNER", "user.email", "read"))
+	suite.Len(e.GetPolicy(), 3)
+
+	// seeding again adds nothing
+	e = initCasbin(suite.redisAddress)
+	suite.Len(e.GetPolicy(), 3)
+
+	// leave only the default policies to the other tests
+	e.RemovePolicy("SUPER_ADMIN", "user.name", "read")
+	suite.Require().NoError(e.SavePolicy())
+}
+
+// Cross-origin requests are allowed only from the listed origins
+func (suite *RouterSuite) TestCORSOptions() {
+	for _, c := range []struct {
+		name        string
+		origins     []string
+		origin      string
+		allowed     bool
+		credentials bool
+	}{
+		{name: "default", origin: "https://evil.example.com"},
+		{name: "listed", origins: []string{"https://www.example.jp"}, origin: "https://www.example.jp", allowed: true, credentials: true},
+		{name: "not listed", origins: []string{"https://www.example.jp"}, origin: "https://evil.example.com"},
+		{name: "any", origins: []string{"*"}, origin: "https://evil.example.com", allowed: true},
+	} {
+		h := cors.New(corsOptions(c.origins)).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+		r := httptest.NewRequest("OPTIONS", "/query", nil)
+		r.Header.Set("Origin", c.origin)
+		r.Header.Set("Access-Control-Request-Method", "POST")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		suite.Equal(c.allowed, w.Header().Get("Access-Control-Allow-Origin") != "", c.name)
+		suite.Equal(c.credentials, w.Header().Get("Access-Control-Allow-Credentials") == "true", c.name)
+	}
+}
+
+func TestRouterSuite(t *testing.T) {
+	suite.Run(t, new(RouterSuite))
+}
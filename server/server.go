@@ -1,18 +1,33 @@
 package server
 
 import (
+	"context"
+
 	"github.com/go-chi/chi"
 	_ "github.com/jinzhu/gorm/dialects/mysql"
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/shufo/go-graphql-boilerplate/mailqueue"
+	"github.com/shufo/go-graphql-boilerplate/ratelimit"
+	"github.com/shufo/go-graphql-boilerplate/sns"
 )
 
 // Server represents main server structure
 type Server struct {
-	router *chi.Mux
-	config Config
+	router    *chi.Mux
+	config    Config
+	mailQueue *mailqueue.Queue
 }
 
 type Config struct {
+	// App is the config of the application validated by configs.Load
+	App     configs.Config
 	Logging bool
+	// SNSCertificates verifies the SNS messages of SES notifications.
+	// The certificates are downloaded from SNS if it is nil.
+	SNSCertificates sns.CertificateSource
+	// RateLimitStore keeps the rate limits of clients.
+	// The store selected by RATE_LIMIT_STORE is used if it is nil.
+	RateLimitStore ratelimit.Store
 }
 
 // NewServer returns server with initialized router
@@ -23,5 +38,11 @@ func NewServer(c Config) *Server {
 	}
 }
 
+// RunMailQueue delivers the mails queued by the resolvers until ctx is canceled.
+// It must be called after Router.
+func (s *Server) RunMailQueue(ctx context.Context) {
+	s.mailQueue.Run(ctx)
+}
+
 func init() {
 }
@@ -0,0 +1,283 @@
+// Package sns verifies the messages which Amazon SNS posts to HTTP endpoints
+package sns
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Types of the messages
+const (
+	TypeNotification             = "Notification"
+	TypeSubscriptionConfirmation = "SubscriptionConfirmation"
+	TypeUnsubscribeConfirmation  = "UnsubscribeConfirmation"
+)
+
+// Message is the message posted by SNS
+type Message struct {
+	Type             string
+	MessageId        string
+	Token            string `json:",omitempty"`
+	TopicArn         string
+	Subject          string `json:",omitempty"`
+	Message          string
+	Timestamp        string
+	SignatureVersion string
+	Signature        string
+	SigningCertURL   string
+	SubscribeURL     string `json:",omitempty"`
+	UnsubscribeURL   string `json:",omitempty"`
+}
+
+// maxMessageSize is the maximum size of the request body. SNS messages are up to 256KB.
+const maxMessageSize = 512 << 10
+
+// ParseMessage reads the message from the request body
+func ParseMessage(r io.Reader) (*Message, error) {
+	m := &Message{}
+
+	if err := json.NewDecoder(io.LimitReader(r, maxMessageSize)).Decode(m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// StringToSign returns the text signed by SNS, which is the pairs of the name and
+// the value of the signed fields in alphabetical order separated by new lines
+func (m *Message) StringToSign() string {
+	fields := [][2]string{{"Message", m.Message}, {"MessageId", m.MessageId}}
+
+	if m.Type == TypeNotification {
+		if m.Subject != "" {
+			fields = append(fields, [2]string{"Subject", m.Subject})
+		}
+
+		fields = append(fields, [][2]string{{"Timestamp", m.Timestamp}, {"TopicArn", m.TopicArn}, {"Type", m.Type}}...)
+	} else {
+		fields = append(fields, [][2]string{
+			{"SubscribeURL", m.SubscribeURL},
+			{"Timestamp", m.Timestamp},
+			{"Token", m.Token},
+			{"TopicArn", m.TopicArn},
+			{"Type", m.Type},
+		}...)
+	}
+
+	var b strings.Builder
+
+	for _, f := range fields {
+		b.WriteString(f[0] + "\n" + f[1] + "\n")
+	}
+
+	return b.String()
+}
+
+// CertificateSource returns the certificate at the SigningCertURL
+type CertificateSource interface {
+	Certificate(ctx context.Context, certURL string) (*x509.Certificate, error)
+}
+
+// Verifier verifies the signature of the messages and the topic they are sent from
+type Verifier struct {
+	certs  CertificateSource
+	topics map[string]bool
+}
+
+// NewVerifier returns the verifier accepting the messages only from topicArns.
+// No message is accepted if topicArns is empty.
+func NewVerifier(certs CertificateSource, topicArns []string) *Verifier {
+	topics := map[string]bool{}
+
+	for _, arn := range topicArns {
+		if arn = strings.TrimSpace(arn); arn != "" {
+			topics[arn] = true
+		}
+	}
+
+	return &Verifier{certs: certs, topics: topics}
+}
+
+// Verify returns the error if the message is not signed by SNS or is sent from other topics
+func (v *Verifier) Verify(ctx context.Context, m *Message) error {
+	if !v.topics[m.TopicArn] {
+		return fmt.Errorf("sns: topic %s is not accepted", m.TopicArn)
+	}
+
+	var hash crypto.Hash
+
+	switch m.SignatureVersion {
+	case "1":
+		hash = crypto.SHA1
+	case "2":
+		hash = crypto.SHA256
+	default:
+		return fmt.Errorf("sns: unsupported signature version %q", m.SignatureVersion)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(m.Signature)
+
+	if err != nil {
+		return fmt.Errorf("sns: invalid signature: %v", err)
+	}
+
+	cert, err := v.certs.Certificate(ctx, m.SigningCertURL)
+
+	if err != nil {
+		return err
+	}
+
+	key, ok := cert.PublicKey.(*rsa.PublicKey)
+
+	if !ok {
+		return fmt.Errorf("sns: signing certificate does not have RSA key")
+	}
+
+	if err := rsa.VerifyPKCS1v15(key, hash, digest(hash, m.StringToSign()), signature); err != nil {
+		return fmt.Errorf("sns: signature mismatch")
+	}
+
+	return nil
+}
+
+func digest(hash crypto.Hash, text string) []byte {
+	if hash == crypto.SHA1 {
+		d := sha1.Sum([]byte(text))
+		return d[:]
+	}
+
+	d := sha256.Sum256([]byte(text))
+	return d[:]
+}
+
+// awsHost matches the hosts of SNS which serve the certificates and the subscription URLs
+var awsHost = regexp.MustCompile(`^sns\.[a-z0-9\-]+\.amazonaws\.com(\.cn)?$`)
+
+// validateURL returns the error unless u is an HTTPS URL of SNS
+func validateURL(u string) error {
+	parsed, err := url.Parse(u)
+
+	if err != nil || parsed.Scheme != "https" || !awsHost.MatchString(parsed.Hostname()) {
+		return fmt.Errorf("sns: %q is not an URL of SNS", u)
+	}
+
+	return nil
+}
+
+// HTTPCertificates downloads the certificates from SNS and caches them
+type HTTPCertificates struct {
+	client *http.Client
+
+	mu    sync.Mutex
+	certs map[string]*x509.Certificate
+}
+
+// NewHTTPCertificates returns the certificate source downloading with client.
+// http.DefaultClient is used if client is nil.
+func NewHTTPCertificates(client *http.Client) *HTTPCertificates {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &HTTPCertificates{client: client, certs: map[string]*x509.Certificate{}}
+}
+
+// Certificate downloads the certificate unless it is cached.
+// Only the certificates served by SNS over HTTPS are accepted.
+func (h *HTTPCertificates) Certificate(ctx context.Context, certURL string) (*x509.Certificate, error) {
+	if err := validateURL(certURL); err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	cert, ok := h.certs[certURL]
+	h.mu.Unlock()
+
+	if ok {
+		return cert, nil
+	}
+
+	req, err := http.NewRequest(http.MethodGet, certURL, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.client.Do(req.WithContext(ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("sns: failed to get certificate: %s", res.Status)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, 64<<10))
+
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(body)
+
+	if block == nil {
+		return nil, fmt.Errorf("sns: certificate is not PEM encoded")
+	}
+
+	cert, err = x509.ParseCertificate(block.Bytes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	h.certs[certURL] = cert
+	h.mu.Unlock()
+
+	return cert, nil
+}
+
+// ConfirmSubscription visits the SubscribeURL of the verified subscription confirmation
+func ConfirmSubscription(ctx context.Context, client *http.Client, m *Message) error {
+	if err := validateURL(m.SubscribeURL); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, m.SubscribeURL, nil)
+
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Do(req.WithContext(ctx))
+
+	if err != nil {
+		return err
+	}
+
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("sns: failed to confirm subscription: %s", res.Status)
+	}
+
+	return nil
+}
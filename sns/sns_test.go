@@ -0,0 +1,126 @@
+package sns_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/shufo/go-graphql-boilerplate/sns"
+	"github.com/shufo/go-graphql-boilerplate/sns/snstest"
+	"github.com/stretchr/testify/suite"
+)
+
+// transport records the requested URLs and responds with 404
+type transport struct {
+	requested []string
+}
+
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.requested = append(t.requested, r.URL.String())
+
+	return &http.Response{
+		StatusCode: http.StatusNotFound,
+		Status:     "404 Not Found",
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		Request:    r,
+	}, nil
+}
+
+type SNSSuite struct {
+	suite.Suite
+	stub *snstest.Stub
+}
+
+func (suite *SNSSuite) SetupSuite() {
+	stub, err := snstest.NewStub()
+	suite.Require().NoError(err)
+	suite.stub = stub
+}
+
+// message returns the signed notification changed by change
+func (suite *SNSSuite) message(change func(m *sns.Message)) *sns.Message {
+	m, err := sns.ParseMessage(bytes.NewReader(suite.stub.Notification(snstest.TopicArn, `{"notificationType":"Bounce"}`)))
+	suite.Require().NoError(err)
+
+	if change != nil {
+		change(m)
+	}
+
+	return m
+}
+
+// Only the messages signed by SNS for the accepted topics are verified
+func (suite *SNSSuite) TestVerify() {
+	verifier := sns.NewVerifier(suite.stub, []string{" " + snstest.TopicArn + " "})
+
+	for _, c := range []struct {
+		name   string
+		change func(m *sns.Message)
+		valid  bool
+	}{
+		{name: "signed", valid: true},
+		{name: "other topic", change: func(m *sns.Message) { m.TopicArn = "arn:aws:sns:us-east-1:123456789012:other" }},
+		{name: "tampered message", change: func(m *sns.Message) { m.Message = `{"notificationType":"Complaint"}` }},
+		{name: "tampered timestamp", change: func(m *sns.Message) { m.Timestamp = "2019-04-13T10:46:55.000Z" }},
+		{name: "added subject", change: func(m *sns.Message) { m.Subject = "subject" }},
+		{name: "other signature version", change: func(m *sns.Message) { m.SignatureVersion = "2" }},
+		{name: "unsupported signature version", change: func(m *sns.Message) { m.SignatureVersion = "3" }},
+		{name: "signature not base64", change: func(m *sns.Message) { m.Signature = "not base64!" }},
+		{name: "empty signature", change: func(m *sns.Message) { m.Signature = "" }},
+		{name: "unknown certificate", change: func(m *sns.Message) { m.SigningCertURL = "https://sns.us-east-1.amazonaws.com/other.pem" }},
+	} {
+		err := verifier.Verify(context.Background(), suite.message(c.change))
+
+		if c.valid {
+			suite.NoError(err, c.name)
+		} else {
+			suite.Error(err, c.name)
+		}
+	}
+
+	// no message is accepted without topics
+	suite.Error(sns.NewVerifier(suite.stub, nil).Verify(context.Background(), suite.message(nil)))
+}
+
+// The certificates and the subscriptions are requested only from SNS over HTTPS
+func (suite *SNSSuite) TestHost() {
+	for _, c := range []struct {
+		url     string
+		allowed bool
+	}{
+		{url: "https://sns.us-east-1.amazonaws.com/SimpleNotificationService.pem", allowed: true},
+		{url: "https://sns.cn-north-1.amazonaws.com.cn/SimpleNotificationService.pem", allowed: true},
+		{url: "http://sns.us-east-1.amazonaws.com/SimpleNotificationService.pem"},
+		{url: "https://sns.us-east-1.amazonaws.com.evil.example.com/SimpleNotificationService.pem"},
+		{url: "https://evil.example.com/sns.us-east-1.amazonaws.com/SimpleNotificationService.pem"},
+		{url: "https://sns.us-east-1.amazonaws.com@evil.example.com/SimpleNotificationService.pem"},
+		{url: "https://evil.example.com/?sns.us-east-1.amazonaws.com"},
+		{url: "https://s3.us-east-1.amazonaws.com/SimpleNotificationService.pem"},
+		{url: "https://sns.us_east_1.amazonaws.com/SimpleNotificationService.pem"},
+		{url: "sns.us-east-1.amazonaws.com/SimpleNotificationService.pem"},
+		{url: ""},
+	} {
+		t := &transport{}
+		client := &http.Client{Transport: t}
+
+		_, err := sns.NewHTTPCertificates(client).Certificate(context.Background(), c.url)
+		suite.Error(err, c.url)
+
+		err = sns.ConfirmSubscription(context.Background(), client, &sns.Message{SubscribeURL: c.url})
+		suite.Error(err, c.url)
+
+		if c.allowed {
+			suite.Equal([]string{c.url, c.url}, t.requested, c.url)
+			suite.Contains(err.Error(), "404", c.url)
+		} else {
+			suite.Empty(t.requested, c.url)
+			suite.Contains(err.Error(), "is not an URL of SNS", c.url)
+		}
+	}
+}
+
+func TestSNSSuite(t *testing.T) {
+	suite.Run(t, new(SNSSuite))
+}
@@ -0,0 +1,152 @@
+// Package snstest generates signed SNS messages like the ones of SES notifications for tests
+package snstest
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/shufo/go-graphql-boilerplate/sns"
+)
+
+// CertURL is the SigningCertURL of the messages signed by the stub
+const CertURL = "https://sns.us-east-1.amazonaws.com/SimpleNotificationService-stub.pem"
+
+// TopicArn is the topic of the messages unless it is given
+const TopicArn = "arn:aws:sns:us-east-1:123456789012:ses-notifications"
+
+// Stub signs messages with its own key and serves the certificate of the key to the verifier
+type Stub struct {
+	key  *rsa.PrivateKey
+	cert *x509.Certificate
+	seq  int
+}
+
+// NewStub generates the key and the self-signed certificate
+func NewStub() (*Stub, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sns.amazonaws.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := x509.ParseCertificate(der)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Stub{key: key, cert: cert}, nil
+}
+
+// Certificate returns the certificate of the stub for CertURL
+func (s *Stub) Certificate(ctx context.Context, certURL string) (*x509.Certificate, error) {
+	if certURL != CertURL {
+		return nil, fmt.Errorf("snstest: unknown certificate %s", certURL)
+	}
+
+	return s.cert, nil
+}
+
+// Notification returns the signed notification of the message from the topic
+func (s *Stub) Notification(topicArn string, message string) []byte {
+	s.seq++
+
+	m := &sns.Message{
+		Type:             sns.TypeNotification,
+		MessageId:        fmt.Sprintf("00000000-0000-0000-0000-%012d", s.seq),
+		TopicArn:         topicArn,
+		Message:          message,
+		Timestamp:        time.Now().UTC().Format("2006-01-02T15:04:05.000Z"),
+		SignatureVersion: "1",
+		SigningCertURL:   CertURL,
+	}
+
+	return s.sign(m)
+}
+
+// Bounce returns the signed SES notification of the bounce to the recipients.
+// bounceType is Permanent, Transient or Undetermined.
+func (s *Stub) Bounce(bounceType string, recipients ...string) []byte {
+	bounced := []map[string]string{}
+
+	for _, r := range recipients {
+		bounced = append(bounced, map[string]string{"emailAddress": r})
+	}
+
+	return s.Notification(TopicArn, sesNotification(map[string]interface{}{
+		"notificationType": "Bounce",
+		"bounce": map[string]interface{}{
+			"bounceType":        bounceType,
+			"bounceSubType":     "General",
+			"bouncedRecipients": bounced,
+			"feedbackId":        fmt.Sprintf("bounce-%d", s.seq+1),
+		},
+	}))
+}
+
+// Complaint returns the signed SES notification of the complaint from the recipients
+func (s *Stub) Complaint(recipients ...string) []byte {
+	complained := []map[string]string{}
+
+	for _, r := range recipients {
+		complained = append(complained, map[string]string{"emailAddress": r})
+	}
+
+	return s.Notification(TopicArn, sesNotification(map[string]interface{}{
+		"notificationType": "Complaint",
+		"complaint": map[string]interface{}{
+			"complainedRecipients":  complained,
+			"complaintFeedbackType": "abuse",
+			"feedbackId":            fmt.Sprintf("complaint-%d", s.seq+1),
+		},
+	}))
+}
+
+func sesNotification(n map[string]interface{}) string {
+	n["mail"] = map[string]interface{}{
+		"source":    "info@example.co.jp",
+		"messageId": "stub",
+	}
+
+	b, _ := json.Marshal(n)
+
+	return string(b)
+}
+
+func (s *Stub) sign(m *sns.Message) []byte {
+	digest := sha1.Sum([]byte(m.StringToSign()))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA1, digest[:])
+
+	if err != nil {
+		panic(err)
+	}
+
+	m.Signature = base64.StdEncoding.EncodeToString(signature)
+
+	b, _ := json.Marshal(m)
+
+	return b
+}
@@ -0,0 +1,144 @@
+package suppression
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+
+	"github.com/shufo/go-graphql-boilerplate/database"
+	"github.com/shufo/go-graphql-boilerplate/sns"
+	"github.com/sirupsen/logrus"
+)
+
+// sesNotification is the SES notification of bounces and complaints published to SNS.
+// eventType is set instead of notificationType by the configuration set event publishing.
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	EventType        string `json:"eventType"`
+	Bounce           *struct {
+		BounceType        string `json:"bounceType"`
+		BounceSubType     string `json:"bounceSubType"`
+		BouncedRecipients []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"bouncedRecipients"`
+		FeedbackID string `json:"feedbackId"`
+	} `json:"bounce"`
+	Complaint *struct {
+		ComplainedRecipients []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+		ComplaintFeedbackType string `json:"complaintFeedbackType"`
+		FeedbackID            string `json:"feedbackId"`
+	} `json:"complaint"`
+}
+
+// entries returns the addresses to be suppressed by the notification.
+// Only permanent bounces are suppressed as transient bounces may be delivered later.
+func (n *sesNotification) entries() []Entry {
+	entries := []Entry{}
+
+	if n.Bounce != nil && n.Bounce.BounceType == "Permanent" {
+		for _, r := range n.Bounce.BouncedRecipients {
+			entries = append(entries, Entry{
+				Email:      r.EmailAddress,
+				Reason:     ReasonBounce,
+				Detail:     n.Bounce.BounceType + "/" + n.Bounce.BounceSubType,
+				FeedbackID: n.Bounce.FeedbackID,
+			})
+		}
+	}
+
+	if n.Complaint != nil {
+		for _, r := range n.Complaint.ComplainedRecipients {
+			entries = append(entries, Entry{
+				Email:      r.EmailAddress,
+				Reason:     ReasonComplaint,
+				Detail:     n.Complaint.ComplaintFeedbackType,
+				FeedbackID: n.Complaint.FeedbackID,
+			})
+		}
+	}
+
+	return entries
+}
+
+// SESHandler receives the SES notifications of bounces and complaints from SNS and
+// adds the recipients to the suppression list. Messages which are not signed by SNS
+// are rejected. Subscriptions to the topics accepted by verifier are confirmed.
+func SESHandler(db *sql.DB, verifier *sns.Verifier, logger logrus.FieldLogger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx := r.Context()
+
+		m, err := sns.ParseMessage(r.Body)
+
+		if err != nil {
+			http.Error(w, "invalid message", http.StatusBadRequest)
+			return
+		}
+
+		log := logger.WithFields(logrus.Fields{"sns_message_id": m.MessageId, "sns_topic_arn": m.TopicArn})
+
+		if err := verifier.Verify(ctx, m); err != nil {
+			log.WithError(err).Warn("rejected SNS message")
+			http.Error(w, "invalid signature", http.StatusForbidden)
+			return
+		}
+
+		switch m.Type {
+		case sns.TypeSubscriptionConfirmation:
+			if err := sns.ConfirmSubscription(ctx, http.DefaultClient, m); err != nil {
+				log.WithError(err).Error("failed to confirm SNS subscription")
+				http.Error(w, "failed to confirm subscription", http.StatusInternalServerError)
+				return
+			}
+
+			log.Info("confirmed SNS subscription")
+		case sns.TypeNotification:
+			n := &sesNotification{}
+
+			if err := json.Unmarshal([]byte(m.Message), n); err != nil {
+				log.WithError(err).Warn("SNS notification is not SES notification")
+				http.Error(w, "invalid notification", http.StatusBadRequest)
+				return
+			}
+
+			entries := n.entries()
+
+			err := database.Transaction(ctx, db, func(ctx context.Context, tx *sql.Tx) error {
+				for _, e := range entries {
+					if err := Add(ctx, tx, e); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			})
+
+			if err != nil {
+				// SNS retries the delivery on errors
+				log.WithError(err).Error("failed to record SES notification")
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+
+			typ := n.NotificationType
+
+			if typ == "" {
+				typ = n.EventType
+			}
+
+			log.WithFields(logrus.Fields{
+				"notification_type": typ,
+				"suppressed":        len(entries),
+			}).Info("received SES notification")
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,93 @@
+// Package suppression keeps the addresses which mails must not be sent to,
+// like the ones which hard bounced or complained
+package suppression
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/shufo/go-graphql-boilerplate/database"
+	"github.com/shufo/go-graphql-boilerplate/mail"
+	"github.com/shufo/go-graphql-boilerplate/models"
+	"github.com/volatiletech/null"
+	"github.com/volatiletech/sqlboiler/boil"
+)
+
+// Reasons of the suppression
+const (
+	ReasonBounce    = "bounce"
+	ReasonComplaint = "complaint"
+)
+
+// Entry is the address to be suppressed
+type Entry struct {
+	Email  string
+	Reason string
+	// Detail is the bounce type or the complaint feedback type
+	Detail string
+	// FeedbackID is the ID of the notification
+	FeedbackID string
+}
+
+// normalize makes the addresses comparable
+func normalize(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// Add adds the address to the suppression list. The reason is updated if it is already listed.
+func Add(ctx context.Context, exec boil.ContextExecutor, e Entry) error {
+	email := normalize(e.Email)
+
+	s, err := models.MailSuppressions(models.MailSuppressionWhere.Email.EQ(email)).One(ctx, exec)
+
+	if err == sql.ErrNoRows {
+		s = &models.MailSuppression{Email: email}
+	} else if err != nil {
+		return err
+	}
+
+	s.Reason = e.Reason
+	s.Detail = null.NewString(e.Detail, e.Detail != "")
+	s.FeedbackID = null.NewString(e.FeedbackID, e.FeedbackID != "")
+
+	if s.ID == 0 {
+		return s.Insert(ctx, exec, boil.Infer())
+	}
+
+	_, err = s.Update(ctx, exec, boil.Infer())
+
+	return err
+}
+
+// IsSuppressed reports whether mails must not be sent to the address
+func IsSuppressed(ctx context.Context, exec boil.ContextExecutor, email string) (bool, error) {
+	return models.MailSuppressions(models.MailSuppressionWhere.Email.EQ(normalize(email))).Exists(ctx, exec)
+}
+
+// Mailer refuses to send mails to the suppressed addresses with mail.ErrSuppressed
+type Mailer struct {
+	db   *sql.DB
+	next mail.Mailer
+}
+
+// NewMailer returns the mailer sending mails by next unless the recipient is suppressed.
+// It joins the transaction in the context only if next does.
+func NewMailer(db *sql.DB, next mail.Mailer) *Mailer {
+	return &Mailer{db: db, next: next}
+}
+
+// Send sends the mail by the next mailer
+func (m *Mailer) Send(ctx context.Context, msg *mail.Mail) error {
+	suppressed, err := IsSuppressed(ctx, database.Executor(ctx, m.db), msg.Recipient)
+
+	if err != nil {
+		return err
+	}
+
+	if suppressed {
+		return mail.ErrSuppressed
+	}
+
+	return m.next.Send(ctx, msg)
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/go-chi/chi"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/go-testfixtures/testfixtures"
+	"github.com/shufo/go-graphql-boilerplate/clock"
+	"github.com/shufo/go-graphql-boilerplate/configs"
+	"github.com/shufo/go-graphql-boilerplate/ratelimit"
 	"github.com/shufo/go-graphql-boilerplate/server"
 	"github.com/shufo/go-graphql-boilerplate/utils"
 )
@@ -74,7 +77,26 @@ func MigrateDB(db *sql.DB) {
 
 func PrepareRouter(db *sql.DB) *chi.Mux {
 	// prepare router for testing
-	c := server.Config{Logging: false}
+	return PrepareRouterWithConfig(db, server.Config{Logging: false})
+}
+
+// PrepareRouterWithConfig prepares router with the server config like fake dependencies.
+// The config of the application is read from the environment variables.
+// Rate limits are kept in memory of each router unless the store is given,
+// so that the limits are not carried over from other tests.
+func PrepareRouterWithConfig(db *sql.DB, c server.Config) *chi.Mux {
+	app, err := configs.Load("")
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c.App = app
+
+	if c.RateLimitStore == nil {
+		c.RateLimitStore = ratelimit.NewMemoryStore(clock.Real{})
+	}
+
 	s := server.NewServer(c)
 	r := s.Router(db)
 
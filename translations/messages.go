@@ -43,6 +43,20 @@ var last_name = i18n.Message{
 	Other:       "Last Name",
 }
 
+var full_name = i18n.Message{
+	ID:          "full_name",
+	Description: "The full name of user",
+	One:         "Full Name",
+	Other:       "Full Name",
+}
+
+var country = i18n.Message{
+	ID:          "country",
+	Description: "The country of user",
+	One:         "Country",
+	Other:       "Country",
+}
+
 var phone_number = i18n.Message{
 	ID:          "phone_number",
 	Description: "The phone number of user",
@@ -103,6 +117,13 @@ var user_not_found = i18n.Message{
 	Other:       "The specified user is not found",
 }
 
+var not_found = i18n.Message{
+	ID:          "not_found",
+	Description: "The message requested resource is not found",
+	One:         "The requested resource is not found",
+	Other:       "The requested resource is not found",
+}
+
 var email_or_password_is_incorrect = i18n.Message{
 	ID:          "email_or_password_is_incorrect",
 	Description: "The message for login failed",
@@ -117,6 +138,62 @@ var length_validation = i18n.Message{
 	Other:       "Requires {{.Min}} to {{.Max}} characters",
 }
 
+var length_min_validation = i18n.Message{
+	ID:          "length_min_validation",
+	Description: "The validation message of minimum input length",
+	One:         "Requires at least {{.Min}} characters",
+	Other:       "Requires at least {{.Min}} characters",
+}
+
+var length_max_validation = i18n.Message{
+	ID:          "length_max_validation",
+	Description: "The validation message of maximum input length",
+	One:         "Requires at most {{.Max}} characters",
+	Other:       "Requires at most {{.Max}} characters",
+}
+
+var pattern_validation = i18n.Message{
+	ID:          "pattern_validation",
+	Description: "The validation message of input format",
+	One:         "Invalid format",
+	Other:       "Invalid format",
+}
+
+var range_validation = i18n.Message{
+	ID:          "range_validation",
+	Description: "The validation message of number range",
+	One:         "Requires a number between {{.Min}} and {{.Max}}",
+	Other:       "Requires a number between {{.Min}} and {{.Max}}",
+}
+
+var range_min_validation = i18n.Message{
+	ID:          "range_min_validation",
+	Description: "The validation message of minimum number",
+	One:         "Requires a number greater than or equal to {{.Min}}",
+	Other:       "Requires a number greater than or equal to {{.Min}}",
+}
+
+var range_max_validation = i18n.Message{
+	ID:          "range_max_validation",
+	Description: "The validation message of maximum number",
+	One:         "Requires a number less than or equal to {{.Max}}",
+	Other:       "Requires a number less than or equal to {{.Max}}",
+}
+
+var phone_number_validation = i18n.Message{
+	ID:          "phone_number_validation",
+	Description: "The validation message of E.164 phone number format",
+	One:         "Requires E.164 phone number format like +819012345678",
+	Other:       "Requires E.164 phone number format like +819012345678",
+}
+
+var country_code_validation = i18n.Message{
+	ID:          "country_code_validation",
+	Description: "The validation message of ISO 3166-1 alpha-2 country code",
+	One:         "Requires ISO 3166-1 alpha-2 country code like JP",
+	Other:       "Requires ISO 3166-1 alpha-2 country code like JP",
+}
+
 var email_validation = i18n.Message{
 	ID:          "email_validation",
 	Description: "The validation message of email format",
@@ -145,38 +222,195 @@ var verified_token_not_found = i18n.Message{
 	Other:       "There is no verified password reset token",
 }
 
-/***********
- * Subjects
- ***********/
+var permission_denied = i18n.Message{
+	ID:          "permission_denied",
+	Description: "The message when user is not granted to access the resource",
+	One:         "You are not granted to access this resource",
+	Other:       "You are not granted to access this resource",
+}
+
+var authentication_required = i18n.Message{
+	ID:          "authentication_required",
+	Description: "The message when the request is not authenticated",
+	One:         "You must be logged in to access this resource",
+	Other:       "You must be logged in to access this resource",
+}
+
+var internal_error = i18n.Message{
+	ID:          "internal_error",
+	Description: "The message for unexpected server errors",
+	One:         "An internal error occurred",
+	Other:       "An internal error occurred",
+}
+
+var upload_too_large = i18n.Message{
+	ID:          "upload_too_large",
+	Description: "The message when the uploaded file is too large",
+	One:         "The file must be {{.Max}} bytes or smaller",
+	Other:       "The file must be {{.Max}} bytes or smaller",
+}
+
+var invalid_multipart_request = i18n.Message{
+	ID:          "invalid_multipart_request",
+	Description: "The message when the multipart request doesn't follow the spec",
+	One:         "The multipart request is invalid",
+	Other:       "The multipart request is invalid",
+}
+
+var file_not_uploaded = i18n.Message{
+	ID:          "file_not_uploaded",
+	Description: "The message when the file of the Upload argument is not sent by the multipart request",
+	One:         "The file is not uploaded by the multipart request",
+	Other:       "The file is not uploaded by the multipart request",
+}
 
-var subject_password_reset = i18n.Message{
-	ID:          "subject_password_reset",
-	Description: "The subject of password reset email",
-	One:         "Change password for example",
-	Other:       "Change password for example",
+var rate_limited = i18n.Message{
+	ID:          "rate_limited",
+	Description: "The message when the client sends too many requests",
+	One:         "Too many requests. Please try again later",
+	Other:       "Too many requests. Please try again later",
 }
 
-var subject_password_reset_complete = i18n.Message{
-	ID:          "subject_password_reset_complete",
-	Description: "The subject of password reset complete",
-	One:         "Password reset complete",
-	Other:       "Password reset complete",
+var unsupported_image = i18n.Message{
+	ID:          "unsupported_image",
+	Description: "The message when the uploaded file is not a supported image",
+	One:         "The image must be JPEG, PNG or GIF",
+	Other:       "The image must be JPEG, PNG or GIF",
+}
+
+var image_dimensions_too_large = i18n.Message{
+	ID:          "image_dimensions_too_large",
+	Description: "The message when the width and height of the image are too large",
+	One:         "The image dimensions are too large",
+	Other:       "The image dimensions are too large",
+}
+
+var invalid_cursor = i18n.Message{
+	ID:          "invalid_cursor",
+	Description: "The message when pagination cursor is invalid",
+	One:         "The cursor is invalid",
+	Other:       "The cursor is invalid",
+}
+
+var invalid_id = i18n.Message{
+	ID:          "invalid_id",
+	Description: "The message when global id is invalid",
+	One:         "The id is invalid",
+	Other:       "The id is invalid",
+}
+
+var query_depth_exceeded = i18n.Message{
+	ID:          "query_depth_exceeded",
+	Description: "The message when the query is nested deeper than the limit",
+	One:         "The query depth {{.Actual}} exceeds the maximum depth {{.Max}}",
+	Other:       "The query depth {{.Actual}} exceeds the maximum depth {{.Max}}",
+}
+
+var query_complexity_exceeded = i18n.Message{
+	ID:          "query_complexity_exceeded",
+	Description: "The message when the query is more complex than the limit",
+	One:         "The query complexity {{.Actual}} exceeds the maximum complexity {{.Max}}",
+	Other:       "The query complexity {{.Actual}} exceeds the maximum complexity {{.Max}}",
+}
+
+var persisted_query_hash_mismatch = i18n.Message{
+	ID:          "persisted_query_hash_mismatch",
+	Description: "The message when the hash of persisted query doesn't match with the query",
+	One:         "The hash doesn't match with the query",
+	Other:       "The hash doesn't match with the query",
+}
+
+var persisted_query_not_allowed = i18n.Message{
+	ID:          "persisted_query_not_allowed",
+	Description: "The message when the query is not registered in the manifest",
+	One:         "The query is not allowed",
+	Other:       "The query is not allowed",
+}
+
+var first_and_last_given = i18n.Message{
+	ID:          "first_and_last_given",
+	Description: "The message when both first and last are given to paginate",
+	One:         "first and last can't be used together",
+	Other:       "first and last can't be used together",
+}
+
+var email_undeliverable = i18n.Message{
+	ID:          "email_undeliverable",
+	Description: "The message mails can not be delivered to the address as it bounced or complained",
+	One:         "Mails can not be delivered to the email address",
+	Other:       "Mails can not be delivered to the email address",
+}
+
+var mail_message_not_failed = i18n.Message{
+	ID:          "mail_message_not_failed",
+	Description: "The message mail to retry has not failed",
+	One:         "Only failed mails can be retried",
+	Other:       "Only failed mails can be retried",
+}
+
+var mail_message_redacted = i18n.Message{
+	ID:          "mail_message_redacted",
+	Description: "The message mail to retry has secrets removed",
+	One:         "Mails with secrets can not be retried as their bodies are removed. Request them again",
+	Other:       "Mails with secrets can not be retried as their bodies are removed. Request them again",
+}
+
+var transactional_notification_required = i18n.Message{
+	ID:          "transactional_notification_required",
+	Description: "The message when disabling the notifications required for the account",
+	One:         "Account notifications can not be disabled",
+	Other:       "Account notifications can not be disabled",
 }
 
 /***********
- * Templates
+ * pages
  ***********/
 
-var email_jpassword_reset = i18n.Message{
-	ID:          "email_password_reset",
-	Description: "The password reset email",
-	One:         "<p>Sent by example.jp.</p>Please click {{.ResetLink}} in 24 hours.<br>Thank you.",
-	Other:       "<p>Sent by example.jp.</p>Please click {{.ResetLink}} in 24 hours.<br>Thank you.",
+var unsubscribe_title = i18n.Message{
+	ID:          "unsubscribe_title",
+	Description: "The title of the page to unsubscribe from mails",
+	One:         "Unsubscribe",
+	Other:       "Unsubscribe",
+}
+
+var unsubscribe_confirm = i18n.Message{
+	ID:          "unsubscribe_confirm",
+	Description: "The question on the page to unsubscribe from the category of mails",
+	One:         "Do you want to stop receiving {{.Category}} emails?",
+	Other:       "Do you want to stop receiving {{.Category}} emails?",
+}
+
+var unsubscribe_button = i18n.Message{
+	ID:          "unsubscribe_button",
+	Description: "The label of the button to unsubscribe from mails",
+	One:         "Unsubscribe",
+	Other:       "Unsubscribe",
+}
+
+var unsubscribe_done = i18n.Message{
+	ID:          "unsubscribe_done",
+	Description: "The message when the user has unsubscribed from the category of mails",
+	One:         "You will no longer receive {{.Category}} emails.",
+	Other:       "You will no longer receive {{.Category}} emails.",
+}
+
+var invalid_unsubscribe_link = i18n.Message{
+	ID:          "invalid_unsubscribe_link",
+	Description: "The message when the unsubscribe link is forged or broken",
+	One:         "The unsubscribe link is invalid",
+	Other:       "The unsubscribe link is invalid",
+}
+
+var notification_category_product_updates = i18n.Message{
+	ID:          "notification_category_product_updates",
+	Description: "The name of the notifications about product updates",
+	One:         "product update",
+	Other:       "product update",
 }
 
-var email_password_reset_complete = i18n.Message{
-	ID:          "email_password_reset_complete",
-	Description: "The password reset email",
-	One:         "<p>Password reset complete.</p>.",
-	Other:       "<p>Password reset complete.</p>",
+var notification_category_marketing = i18n.Message{
+	ID:          "notification_category_marketing",
+	Description: "The name of the notifications about campaigns and offers",
+	One:         "marketing",
+	Other:       "marketing",
 }
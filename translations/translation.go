@@ -5,34 +5,54 @@ import (
 	"net/http"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
 )
 
 var I18nCtxKey = &ContextKey{name: "translation"}
 
+var languageCtxKey = &ContextKey{name: "language"}
+
 type ContextKey struct {
 	name string
 }
 
-func Middleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		bundle := r.Context().Value("bundle").(*i18n.Bundle)
+// Middleware puts the localizer of the requested language in the context
+func Middleware(bundle *i18n.Bundle) func(http.Handler) http.Handler {
+	matcher := language.NewMatcher(bundle.LanguageTags())
 
-		// Specify language by request
-		lang := r.FormValue("lang")
-		accept := r.Header.Get("Accept-Language")
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Specify language by request. Only the query string is read as
+			// parsing the form would read the body before its size is limited.
+			lang := r.URL.Query().Get("lang")
+			accept := r.Header.Get("Accept-Language")
 
-		// Init localizer
-		localizer := i18n.NewLocalizer(bundle, lang, accept)
+			// Init localizer
+			localizer := i18n.NewLocalizer(bundle, lang, accept)
 
-		// Set context
-		ctx := context.WithValue(r.Context(), I18nCtxKey, localizer)
+			// the language used for contents other than messages like mails
+			tag, _ := language.MatchStrings(matcher, lang, accept)
 
-		// and call the next with our new context
-		r = r.WithContext(ctx)
+			// Set context
+			ctx := context.WithValue(r.Context(), I18nCtxKey, localizer)
+			ctx = context.WithValue(ctx, languageCtxKey, tag)
 
-		next.ServeHTTP(w, r)
-	})
+			// and call the next with our new context
+			r = r.WithContext(ctx)
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Language returns the requested language supported by the translations.
+// It returns English if the context has no language.
+func Language(ctx context.Context) language.Tag {
+	if tag, ok := ctx.Value(languageCtxKey).(language.Tag); ok {
+		return tag
+	}
 
+	return language.English
 }
 
 func T(ctx context.Context, key string) string {
@@ -51,3 +71,16 @@ func TWithTemplateData(ctx context.Context, key string, data map[string]interfac
 		TemplateData: data,
 	})
 }
+
+// TWithDefault translates the key or returns defaultMessage if there is no translation for the key
+func TWithDefault(ctx context.Context, key string, defaultMessage string) string {
+	l := ctx.Value(I18nCtxKey).(*i18n.Localizer)
+
+	return l.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: key,
+		DefaultMessage: &i18n.Message{
+			ID:    key,
+			Other: defaultMessage,
+		},
+	})
+}
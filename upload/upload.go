@@ -0,0 +1,225 @@
+// Package upload implements GraphQL multipart request spec
+// (https://github.com/jaydenseric/graphql-multipart-request-spec).
+//
+// Middleware replaces each file in the request with a random id in the
+// variables, and the resolvers look up the file of the Upload scalar by the id
+// with FileForContext. Files are kept in the context of the request, so that
+// other requests can't resolve them.
+package upload
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/shufo/go-graphql-boilerplate/apperror"
+	"github.com/shufo/go-graphql-boilerplate/utils"
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+// maxMemory is the size of files kept in memory. Larger files are written to temporary files.
+const maxMemory = 8 << 20
+
+// File is the uploaded file
+type File struct {
+	File     io.ReadSeeker
+	Filename string
+	Size     int64
+	// ContentType sent by the client. Don't trust it, sniff the content instead.
+	ContentType string
+}
+
+// ID is the id of the file in the variables given by Middleware
+type ID string
+
+type contextKey struct {
+	name string
+}
+
+var filesCtxKey = &contextKey{name: "upload_files"}
+
+// FileForContext returns the file of the id uploaded by the request of the context
+func FileForContext(ctx context.Context, id ID) (*File, bool) {
+	files, _ := ctx.Value(filesCtxKey).(map[ID]*File)
+	f, ok := files[id]
+
+	return f, ok
+}
+
+// Middleware converts multipart requests into JSON requests.
+// Requests larger than maxSize bytes are rejected.
+func Middleware(maxSize int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			tooLarge := func() *gqlerror.Error {
+				return apperror.NewWithTemplateData(ctx, apperror.CodeValidationFailed, "upload_too_large", map[string]interface{}{"Max": maxSize})
+			}
+
+			if r.ContentLength > maxSize {
+				writeError(w, http.StatusRequestEntityTooLarge, tooLarge())
+				return
+			}
+
+			r.Body = http.MaxBytesReader(w, r.Body, maxSize)
+
+			if err := r.ParseMultipartForm(maxMemory); err != nil {
+				// body without Content-Length is read up to maxSize
+				if strings.Contains(err.Error(), "request body too large") {
+					writeError(w, http.StatusRequestEntityTooLarge, tooLarge())
+				} else {
+					writeError(w, http.StatusBadRequest, apperror.New(ctx, apperror.CodeValidationFailed, "invalid_multipart_request"))
+				}
+				return
+			}
+
+			defer r.MultipartForm.RemoveAll()
+
+			files, body, err := rewrite(r.MultipartForm)
+
+			defer func() {
+				for _, f := range files {
+					if c, ok := f.File.(io.Closer); ok {
+						c.Close()
+					}
+				}
+			}()
+
+			if err != nil {
+				writeError(w, http.StatusBadRequest, apperror.New(ctx, apperror.CodeValidationFailed, "invalid_multipart_request"))
+				return
+			}
+
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			r.ContentLength = int64(len(body))
+			r.Header.Set("Content-Type", "application/json")
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, filesCtxKey, files)))
+		})
+	}
+}
+
+// rewrite sets the id of each file at the paths of the map field in the operations
+// and returns the files by the ids and the operations as JSON
+func rewrite(form *multipart.Form) (map[ID]*File, []byte, error) {
+	var operations map[string]interface{}
+	var paths map[string][]string
+	ids := map[ID]*File{}
+
+	d := json.NewDecoder(strings.NewReader(value(form, "operations")))
+	d.UseNumber()
+
+	if err := d.Decode(&operations); err != nil {
+		return ids, nil, err
+	}
+
+	if err := json.Unmarshal([]byte(value(form, "map")), &paths); err != nil {
+		return ids, nil, err
+	}
+
+	for key, ps := range paths {
+		headers := form.File[key]
+
+		if len(headers) == 0 {
+			return ids, nil, fmt.Errorf("file %s is missing", key)
+		}
+
+		f, err := headers[0].Open()
+
+		if err != nil {
+			return ids, nil, err
+		}
+
+		id := ID(utils.RandomUUID())
+
+		ids[id] = &File{
+			File:        f,
+			Filename:    headers[0].Filename,
+			Size:        headers[0].Size,
+			ContentType: headers[0].Header.Get("Content-Type"),
+		}
+
+		for _, p := range ps {
+			if err := set(operations, p, string(id)); err != nil {
+				return ids, nil, err
+			}
+		}
+	}
+
+	body, err := json.Marshal(operations)
+
+	return ids, body, err
+}
+
+func value(form *multipart.Form, key string) string {
+	if v := form.Value[key]; len(v) > 0 {
+		return v[0]
+	}
+
+	return ""
+}
+
+// set replaces the value at the path like "variables.files.0"
+func set(operations map[string]interface{}, path string, v interface{}) error {
+	keys := strings.Split(path, ".")
+
+	if len(keys) < 2 || keys[0] != "variables" {
+		return fmt.Errorf("invalid path %s", path)
+	}
+
+	var current interface{} = operations
+
+	for i, k := range keys {
+		last := i == len(keys)-1
+
+		switch c := current.(type) {
+		case map[string]interface{}:
+			if _, ok := c[k]; !ok {
+				return fmt.Errorf("invalid path %s", path)
+			}
+
+			if last {
+				c[k] = v
+				return nil
+			}
+
+			current = c[k]
+		case []interface{}:
+			n, err := strconv.Atoi(k)
+
+			if err != nil || n < 0 || n >= len(c) {
+				return fmt.Errorf("invalid path %s", path)
+			}
+
+			if last {
+				c[n] = v
+				return nil
+			}
+
+			current = c[n]
+		default:
+			return fmt.Errorf("invalid path %s", path)
+		}
+	}
+
+	return nil
+}
+
+func writeError(w http.ResponseWriter, status int, err *gqlerror.Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(&graphql.Response{Errors: gqlerror.List{err}})
+}
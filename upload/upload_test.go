@@ -0,0 +1,79 @@
+package upload_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shufo/go-graphql-boilerplate/upload"
+	"github.com/stretchr/testify/suite"
+)
+
+type UploadSuite struct {
+	suite.Suite
+}
+
+// request returns the multipart request uploading the content as variables.file
+func (suite *UploadSuite) request(content string) *http.Request {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	w.WriteField("operations", `{"query": "mutation ($file: Upload!) { upload(file: $file) }", "variables": {"file": null}}`)
+	w.WriteField("map", `{"0": ["variables.file"]}`)
+
+	part, err := w.CreateFormFile("0", "file.txt")
+	suite.Require().NoError(err)
+	part.Write([]byte(content))
+	suite.Require().NoError(w.Close())
+
+	r := httptest.NewRequest("POST", "/query", &body)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+
+	return r
+}
+
+// Files are looked up only with the context of the request uploading them
+func (suite *UploadSuite) TestFileForContext() {
+	var id upload.ID
+	var content []byte
+
+	handler := upload.Middleware(1 << 20)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var operations struct {
+			Variables struct {
+				File upload.ID `json:"file"`
+			} `json:"variables"`
+		}
+		suite.Require().NoError(json.NewDecoder(r.Body).Decode(&operations))
+		id = operations.Variables.File
+
+		f, ok := upload.FileForContext(r.Context(), id)
+		suite.Require().True(ok)
+		suite.Equal("file.txt", f.Filename)
+		content, _ = ioutil.ReadAll(f.File)
+
+		// other requests can't resolve the file
+		_, ok = upload.FileForContext(context.Background(), id)
+		suite.False(ok)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), suite.request("content"))
+
+	suite.NotEmpty(id)
+	suite.Equal("content", string(content))
+
+	// the file of the previous request is not found by the next one
+	handler = upload.Middleware(1 << 20)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, ok := upload.FileForContext(r.Context(), id)
+		suite.False(ok)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), suite.request("other"))
+}
+
+func TestUploadSuite(t *testing.T) {
+	suite.Run(t, new(UploadSuite))
+}